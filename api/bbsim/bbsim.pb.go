@@ -630,6 +630,110 @@ func (m *OltAllocatedResources) GetResources() []*OltAllocatedResource {
 	return nil
 }
 
+type PonResourceUsage struct {
+	PonPortId            uint32   `protobuf:"varint,1,opt,name=PonPortId,proto3" json:"PonPortId,omitempty"`
+	AllocIdsUsed         uint32   `protobuf:"varint,2,opt,name=AllocIdsUsed,proto3" json:"AllocIdsUsed,omitempty"`
+	AllocIdsTotal        uint32   `protobuf:"varint,3,opt,name=AllocIdsTotal,proto3" json:"AllocIdsTotal,omitempty"`
+	GemPortsUsed         uint32   `protobuf:"varint,4,opt,name=GemPortsUsed,proto3" json:"GemPortsUsed,omitempty"`
+	GemPortsTotal        uint32   `protobuf:"varint,5,opt,name=GemPortsTotal,proto3" json:"GemPortsTotal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PonResourceUsage) Reset()         { *m = PonResourceUsage{} }
+func (m *PonResourceUsage) String() string { return proto.CompactTextString(m) }
+func (*PonResourceUsage) ProtoMessage()    {}
+
+func (m *PonResourceUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PonResourceUsage.Unmarshal(m, b)
+}
+func (m *PonResourceUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PonResourceUsage.Marshal(b, m, deterministic)
+}
+func (m *PonResourceUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PonResourceUsage.Merge(m, src)
+}
+func (m *PonResourceUsage) XXX_Size() int {
+	return xxx_messageInfo_PonResourceUsage.Size(m)
+}
+func (m *PonResourceUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_PonResourceUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PonResourceUsage proto.InternalMessageInfo
+
+func (m *PonResourceUsage) GetPonPortId() uint32 {
+	if m != nil {
+		return m.PonPortId
+	}
+	return 0
+}
+
+func (m *PonResourceUsage) GetAllocIdsUsed() uint32 {
+	if m != nil {
+		return m.AllocIdsUsed
+	}
+	return 0
+}
+
+func (m *PonResourceUsage) GetAllocIdsTotal() uint32 {
+	if m != nil {
+		return m.AllocIdsTotal
+	}
+	return 0
+}
+
+func (m *PonResourceUsage) GetGemPortsUsed() uint32 {
+	if m != nil {
+		return m.GemPortsUsed
+	}
+	return 0
+}
+
+func (m *PonResourceUsage) GetGemPortsTotal() uint32 {
+	if m != nil {
+		return m.GemPortsTotal
+	}
+	return 0
+}
+
+type PonResourceUtilization struct {
+	Pons                 []*PonResourceUsage `protobuf:"bytes,1,rep,name=Pons,proto3" json:"Pons,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *PonResourceUtilization) Reset()         { *m = PonResourceUtilization{} }
+func (m *PonResourceUtilization) String() string { return proto.CompactTextString(m) }
+func (*PonResourceUtilization) ProtoMessage()    {}
+
+func (m *PonResourceUtilization) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PonResourceUtilization.Unmarshal(m, b)
+}
+func (m *PonResourceUtilization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PonResourceUtilization.Marshal(b, m, deterministic)
+}
+func (m *PonResourceUtilization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PonResourceUtilization.Merge(m, src)
+}
+func (m *PonResourceUtilization) XXX_Size() int {
+	return xxx_messageInfo_PonResourceUtilization.Size(m)
+}
+func (m *PonResourceUtilization) XXX_DiscardUnknown() {
+	xxx_messageInfo_PonResourceUtilization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PonResourceUtilization proto.InternalMessageInfo
+
+func (m *PonResourceUtilization) GetPons() []*PonResourceUsage {
+	if m != nil {
+		return m.Pons
+	}
+	return nil
+}
+
 type ONU struct {
 	ID                            int32      `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	SerialNumber                  string     `protobuf:"bytes,2,opt,name=SerialNumber,proto3" json:"SerialNumber,omitempty"`
@@ -644,6 +748,8 @@ type ONU struct {
 	ActiveImageEntityId           int32      `protobuf:"varint,13,opt,name=ActiveImageEntityId,proto3" json:"ActiveImageEntityId,omitempty"`
 	CommittedImageEntityId        int32      `protobuf:"varint,14,opt,name=CommittedImageEntityId,proto3" json:"CommittedImageEntityId,omitempty"`
 	Unis                          []*UNI     `protobuf:"bytes,15,rep,name=unis,proto3" json:"unis,omitempty"`
+	LastStateChange               string     `protobuf:"bytes,16,opt,name=LastStateChange,proto3" json:"LastStateChange,omitempty"`
+	UptimeSeconds                 uint64     `protobuf:"varint,17,opt,name=UptimeSeconds,proto3" json:"UptimeSeconds,omitempty"`
 	XXX_NoUnkeyedLiteral          struct{}   `json:"-"`
 	XXX_unrecognized              []byte     `json:"-"`
 	XXX_sizecache                 int32      `json:"-"`
@@ -768,6 +874,20 @@ func (m *ONU) GetUnis() []*UNI {
 	return nil
 }
 
+func (m *ONU) GetLastStateChange() string {
+	if m != nil {
+		return m.LastStateChange
+	}
+	return ""
+}
+
+func (m *ONU) GetUptimeSeconds() uint64 {
+	if m != nil {
+		return m.UptimeSeconds
+	}
+	return 0
+}
+
 type UNI struct {
 	ID                   int32      `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	OnuID                int32      `protobuf:"varint,2,opt,name=OnuID,proto3" json:"OnuID,omitempty"`
@@ -886,6 +1006,7 @@ type Service struct {
 	NeedsPPPoE           bool     `protobuf:"varint,20,opt,name=NeedsPPPoE,proto3" json:"NeedsPPPoE,omitempty"`
 	ConfigureMacAddress  bool     `protobuf:"varint,21,opt,name=ConfigureMacAddress,proto3" json:"ConfigureMacAddress,omitempty"`
 	EnableMacLearning    bool     `protobuf:"varint,22,opt,name=EnableMacLearning,proto3" json:"EnableMacLearning,omitempty"`
+	AssignedIp           string   `protobuf:"bytes,23,opt,name=AssignedIp,proto3" json:"AssignedIp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1070,6 +1191,13 @@ func (m *Service) GetEnableMacLearning() bool {
 	return false
 }
 
+func (m *Service) GetAssignedIp() string {
+	if m != nil {
+		return m.AssignedIp
+	}
+	return ""
+}
+
 type ONUTrafficSchedulers struct {
 	TraffSchedulers      *tech_profile.TrafficSchedulers `protobuf:"bytes,1,opt,name=traffSchedulers,proto3" json:"traffSchedulers,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
@@ -1849,1241 +1977,4430 @@ func (m *Timeout) GetDelay() uint32 {
 	return 0
 }
 
-type Empty struct {
+type NniDhcpTrapVidRequest struct {
+	Vid                  int32    `protobuf:"varint,1,opt,name=Vid,proto3" json:"Vid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Empty) Reset()         { *m = Empty{} }
-func (m *Empty) String() string { return proto.CompactTextString(m) }
-func (*Empty) ProtoMessage()    {}
-func (*Empty) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef7750073d18011b, []int{27}
-}
+func (m *NniDhcpTrapVidRequest) Reset()         { *m = NniDhcpTrapVidRequest{} }
+func (m *NniDhcpTrapVidRequest) String() string { return proto.CompactTextString(m) }
+func (*NniDhcpTrapVidRequest) ProtoMessage()    {}
 
-func (m *Empty) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Empty.Unmarshal(m, b)
+func (m *NniDhcpTrapVidRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NniDhcpTrapVidRequest.Unmarshal(m, b)
 }
-func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Empty.Marshal(b, m, deterministic)
+func (m *NniDhcpTrapVidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NniDhcpTrapVidRequest.Marshal(b, m, deterministic)
 }
-func (m *Empty) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Empty.Merge(m, src)
+func (m *NniDhcpTrapVidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NniDhcpTrapVidRequest.Merge(m, src)
 }
-func (m *Empty) XXX_Size() int {
-	return xxx_messageInfo_Empty.Size(m)
+func (m *NniDhcpTrapVidRequest) XXX_Size() int {
+	return xxx_messageInfo_NniDhcpTrapVidRequest.Size(m)
 }
-func (m *Empty) XXX_DiscardUnknown() {
-	xxx_messageInfo_Empty.DiscardUnknown(m)
+func (m *NniDhcpTrapVidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NniDhcpTrapVidRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Empty proto.InternalMessageInfo
+var xxx_messageInfo_NniDhcpTrapVidRequest proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("bbsim.UniType", UniType_name, UniType_value)
-	proto.RegisterEnum("bbsim.SubActionTypes", SubActionTypes_name, SubActionTypes_value)
-	proto.RegisterEnum("bbsim.OltAllocatedResourceType_Type", OltAllocatedResourceType_Type_name, OltAllocatedResourceType_Type_value)
-	proto.RegisterEnum("bbsim.AlarmType_Types", AlarmType_Types_name, AlarmType_Types_value)
-	proto.RegisterType((*PONPort)(nil), "bbsim.PONPort")
-	proto.RegisterType((*PonAllocatedResources)(nil), "bbsim.PonAllocatedResources")
-	proto.RegisterType((*NNIPort)(nil), "bbsim.NNIPort")
-	proto.RegisterType((*Olt)(nil), "bbsim.Olt")
-	proto.RegisterType((*OltAllocatedResourceType)(nil), "bbsim.OltAllocatedResourceType")
-	proto.RegisterType((*OltAllocatedResource)(nil), "bbsim.OltAllocatedResource")
-	proto.RegisterType((*OltAllocatedResources)(nil), "bbsim.OltAllocatedResources")
-	proto.RegisterType((*ONU)(nil), "bbsim.ONU")
-	proto.RegisterType((*UNI)(nil), "bbsim.UNI")
-	proto.RegisterType((*Service)(nil), "bbsim.Service")
-	proto.RegisterType((*ONUTrafficSchedulers)(nil), "bbsim.ONUTrafficSchedulers")
-	proto.RegisterType((*ONUs)(nil), "bbsim.ONUs")
-	proto.RegisterType((*Services)(nil), "bbsim.Services")
-	proto.RegisterType((*UNIs)(nil), "bbsim.UNIs")
-	proto.RegisterType((*ONURequest)(nil), "bbsim.ONURequest")
-	proto.RegisterType((*PONRequest)(nil), "bbsim.PONRequest")
-	proto.RegisterType((*UNIRequest)(nil), "bbsim.UNIRequest")
-	proto.RegisterType((*AlarmType)(nil), "bbsim.AlarmType")
-	proto.RegisterType((*AlarmParameter)(nil), "bbsim.AlarmParameter")
-	proto.RegisterType((*ONUAlarmRequest)(nil), "bbsim.ONUAlarmRequest")
-	proto.RegisterType((*OLTAlarmRequest)(nil), "bbsim.OLTAlarmRequest")
-	proto.RegisterType((*VersionNumber)(nil), "bbsim.VersionNumber")
-	proto.RegisterType((*LogLevel)(nil), "bbsim.LogLevel")
-	proto.RegisterType((*Response)(nil), "bbsim.Response")
-	proto.RegisterType((*IgmpRequest)(nil), "bbsim.IgmpRequest")
-	proto.RegisterType((*Flows)(nil), "bbsim.Flows")
-	proto.RegisterType((*Timeout)(nil), "bbsim.Timeout")
-	proto.RegisterType((*Empty)(nil), "bbsim.Empty")
+func (m *NniDhcpTrapVidRequest) GetVid() int32 {
+	if m != nil {
+		return m.Vid
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("api/bbsim/bbsim.proto", fileDescriptor_ef7750073d18011b) }
-
-var fileDescriptor_ef7750073d18011b = []byte{
-	// 2290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4f, 0x6f, 0xdb, 0xc8,
-	0x15, 0xb7, 0xfe, 0x59, 0xd2, 0x93, 0x25, 0xd1, 0x93, 0xd8, 0x4b, 0x38, 0xde, 0x8d, 0xc1, 0xcd,
-	0x2e, 0x9c, 0x60, 0xd7, 0xd9, 0x24, 0xdd, 0x6d, 0x72, 0x94, 0x25, 0x5a, 0xe1, 0x5a, 0x26, 0x89,
-	0xa1, 0xe4, 0x20, 0xed, 0x41, 0xa0, 0xc9, 0xb1, 0x4c, 0x94, 0x22, 0x15, 0x92, 0xb2, 0xeb, 0x0f,
-	0xd0, 0x63, 0xcf, 0x3d, 0x2e, 0xd0, 0x0f, 0xd0, 0xef, 0xd1, 0x5b, 0xef, 0x05, 0xfa, 0x55, 0x5a,
-	0xcc, 0x70, 0x48, 0x91, 0x92, 0xec, 0x38, 0xa7, 0x5e, 0x0c, 0xbe, 0xdf, 0xfb, 0x33, 0x6f, 0xde,
-	0x9f, 0x99, 0x37, 0x32, 0xec, 0x98, 0x33, 0xe7, 0xe5, 0xc5, 0x45, 0xe8, 0x4c, 0xe3, 0xbf, 0x47,
-	0xb3, 0xc0, 0x8f, 0x7c, 0x54, 0x61, 0xc4, 0xde, 0x93, 0x6b, 0xdf, 0x8d, 0xae, 0xcc, 0x31, 0x03,
-	0xc3, 0x97, 0xfe, 0x8c, 0x78, 0xbe, 0x1b, 0xc5, 0x32, 0x7b, 0x07, 0x79, 0x66, 0x44, 0xac, 0x2b,
-	0xfa, 0x7d, 0xe9, 0xb8, 0x24, 0x96, 0x90, 0xfe, 0x5b, 0x84, 0xaa, 0xae, 0xa9, 0xba, 0x1f, 0x44,
-	0xa8, 0x05, 0x45, 0xa5, 0x27, 0x16, 0x0e, 0x0a, 0x87, 0x15, 0x5c, 0x54, 0x7a, 0x68, 0x1f, 0xea,
-	0xda, 0x8c, 0x04, 0x46, 0x64, 0x46, 0x44, 0x2c, 0x1e, 0x14, 0x0e, 0xeb, 0x78, 0x01, 0xa0, 0x67,
-	0xd0, 0x54, 0xbc, 0x88, 0x04, 0x9e, 0xe9, 0xc6, 0x12, 0x25, 0x26, 0x91, 0x07, 0xd1, 0x01, 0x34,
-	0x74, 0xd3, 0xfa, 0x13, 0x89, 0xba, 0xfe, 0xdc, 0x8b, 0xc4, 0xf2, 0x41, 0xe1, 0xb0, 0x8c, 0xb3,
-	0x10, 0x3a, 0x81, 0x76, 0xc7, 0x75, 0x7d, 0xcb, 0x8c, 0x88, 0xad, 0x79, 0x73, 0xc5, 0x0e, 0xc5,
-	0xca, 0x41, 0xe9, 0xb0, 0xf1, 0x7a, 0xff, 0x28, 0xde, 0xae, 0xee, 0x7b, 0xa9, 0x00, 0x26, 0xa1,
-	0x3f, 0x0f, 0x2c, 0x12, 0xe2, 0x65, 0x25, 0xf4, 0x2b, 0x6c, 0xa7, 0x50, 0x9f, 0x4c, 0xe9, 0x8e,
-	0x42, 0x71, 0xf3, 0x01, 0x96, 0x56, 0xd5, 0x72, 0xb6, 0xd8, 0x07, 0xf5, 0xaa, 0xfa, 0x45, 0xb6,
-	0x12, 0x35, 0xf4, 0x0d, 0xc0, 0x90, 0x58, 0x57, 0x9e, 0xef, 0xfa, 0x93, 0x5b, 0xb1, 0xc6, 0x82,
-	0x94, 0x41, 0xa4, 0x53, 0xd8, 0x59, 0x6b, 0x0b, 0x49, 0xb0, 0x65, 0x90, 0xc0, 0x31, 0x5d, 0x75,
-	0x3e, 0xbd, 0x20, 0x01, 0x4b, 0x4c, 0x1d, 0xe7, 0x30, 0x96, 0x32, 0x9b, 0xe5, 0x86, 0xa6, 0xcc,
-	0x96, 0x7e, 0x0f, 0x55, 0x55, 0x55, 0xbe, 0x3c, 0x9b, 0xd2, 0x5f, 0x8b, 0x50, 0xd2, 0xdc, 0x55,
-	0xad, 0x65, 0x27, 0x8a, 0x6b, 0x9c, 0xc8, 0x59, 0x2e, 0x7d, 0xb6, 0x4e, 0xca, 0xeb, 0xea, 0x84,
-	0xae, 0xab, 0x8b, 0x55, 0xc6, 0x2a, 0x2a, 0x3a, 0x7a, 0x01, 0x35, 0xbe, 0x91, 0xa4, 0x1c, 0x5a,
-	0x3c, 0xf0, 0x1c, 0xc6, 0x29, 0x9f, 0xca, 0xf2, 0x12, 0x4e, 0x12, 0x9e, 0xc8, 0x72, 0x18, 0xa7,
-	0x7c, 0xf4, 0x3d, 0xb4, 0x54, 0xcf, 0xe9, 0x5d, 0x59, 0xb3, 0x61, 0x60, 0xce, 0xce, 0x1d, 0x9b,
-	0x65, 0xa4, 0x82, 0x97, 0x50, 0xe9, 0x2f, 0x05, 0x10, 0x35, 0x37, 0x5a, 0x49, 0xcb, 0xf0, 0x76,
-	0x46, 0xd0, 0x5b, 0x28, 0x47, 0xb7, 0x33, 0xc2, 0xc2, 0xd4, 0x7a, 0xfd, 0x8c, 0x2f, 0x76, 0x97,
-	0xf8, 0x11, 0xfd, 0x83, 0x99, 0x86, 0xf4, 0x12, 0xca, 0xcc, 0x42, 0x03, 0xaa, 0x23, 0xf5, 0x54,
-	0xd5, 0x3e, 0xa8, 0xc2, 0x06, 0xda, 0x82, 0x5a, 0x67, 0x30, 0xd0, 0xba, 0x63, 0xa5, 0x27, 0x14,
-	0x28, 0xd5, 0x97, 0xcf, 0xc6, 0xba, 0x86, 0x87, 0x42, 0x51, 0xfa, 0x47, 0x01, 0x1e, 0xaf, 0x33,
-	0x8c, 0x50, 0x6c, 0x89, 0x57, 0x45, 0x6c, 0x75, 0x1f, 0xea, 0xba, 0xef, 0xd1, 0x8d, 0xf2, 0xa2,
-	0x68, 0xe2, 0x05, 0x80, 0x1e, 0x43, 0x85, 0xb5, 0x0a, 0x4b, 0x51, 0x13, 0xc7, 0x04, 0xda, 0x85,
-	0x4d, 0xca, 0x57, 0x7d, 0x96, 0x97, 0x26, 0xe6, 0x14, 0x2d, 0xdb, 0x64, 0x2d, 0xc5, 0x16, 0x2b,
-	0x2c, 0x48, 0x19, 0x84, 0xea, 0x9d, 0xb8, 0xfe, 0x8d, 0x62, 0x8b, 0x9b, 0xac, 0xa7, 0x39, 0x25,
-	0x61, 0xd8, 0x59, 0xe7, 0x6f, 0x88, 0xde, 0x41, 0x3d, 0x48, 0x08, 0xb1, 0xc0, 0xd2, 0xf4, 0xe4,
-	0x9e, 0xc8, 0xe1, 0x85, 0xb4, 0xf4, 0x5b, 0x19, 0x4a, 0x9a, 0x3a, 0xfa, 0xbf, 0x15, 0x67, 0x26,
-	0xae, 0x3d, 0x1e, 0x8a, 0x05, 0x80, 0x0e, 0xa0, 0xfe, 0xfe, 0xa6, 0x63, 0xdb, 0x01, 0x09, 0xc3,
-	0xb8, 0xbf, 0x8f, 0x8b, 0x62, 0x01, 0x2f, 0x40, 0xb4, 0x97, 0xc6, 0xb8, 0x4e, 0x95, 0x19, 0x3b,
-	0x89, 0xf3, 0x11, 0xd4, 0x42, 0x12, 0x5c, 0x3b, 0x34, 0x2a, 0x90, 0x2b, 0x5e, 0x23, 0x86, 0x99,
-	0x74, 0x2a, 0x83, 0x7a, 0xf0, 0xb5, 0x32, 0x35, 0x27, 0xc4, 0xf0, 0x2f, 0xa3, 0x1b, 0x33, 0x20,
-	0xf2, 0x9f, 0x67, 0xc4, 0x8a, 0x88, 0x6d, 0x10, 0x2b, 0x72, 0x7c, 0x2f, 0x14, 0x1b, 0xcc, 0xbf,
-	0xfb, 0x85, 0x56, 0xac, 0x60, 0x62, 0x11, 0xe7, 0x3a, 0x63, 0x65, 0x6b, 0x8d, 0x95, 0x65, 0x21,
-	0xf4, 0x13, 0x3c, 0xea, 0x58, 0x91, 0x73, 0x4d, 0x98, 0x98, 0xec, 0x45, 0x4e, 0x74, 0xab, 0xd8,
-	0x62, 0x93, 0xe9, 0xae, 0x63, 0xa1, 0x5f, 0x60, 0xb7, 0xeb, 0x4f, 0xa7, 0x4e, 0x14, 0x11, 0x3b,
-	0xaf, 0xd4, 0x62, 0x4a, 0x77, 0x70, 0xd1, 0x37, 0x50, 0x9e, 0x7b, 0x4e, 0x28, 0xb6, 0x59, 0x84,
-	0x80, 0x47, 0x68, 0xa4, 0x2a, 0x98, 0xe1, 0xd2, 0xbf, 0x0b, 0x50, 0x1a, 0xa9, 0xca, 0x4a, 0x85,
-	0xf0, 0x9a, 0xef, 0xf1, 0x23, 0x32, 0x26, 0x38, 0x6a, 0x78, 0xbc, 0x1e, 0x62, 0x82, 0x76, 0xd4,
-	0x19, 0x51, 0x7a, 0xbc, 0x0f, 0xd8, 0x77, 0xbe, 0x7a, 0x2a, 0xcb, 0xd5, 0xb3, 0xe8, 0x9d, 0x4d,
-	0x66, 0x3e, 0xc9, 0xe9, 0x8b, 0x4c, 0x4e, 0xab, 0xeb, 0x72, 0x9a, 0xc9, 0xa7, 0xc4, 0xfb, 0xb8,
-	0xc6, 0xce, 0x92, 0x44, 0x6e, 0xe4, 0x39, 0xf1, 0xa9, 0x41, 0xff, 0x4a, 0xff, 0xac, 0x40, 0x95,
-	0x6b, 0x52, 0x2f, 0x55, 0x73, 0x9a, 0xf6, 0x3d, 0xfd, 0xa6, 0x5e, 0x2e, 0x2a, 0x90, 0x1f, 0xed,
-	0x8b, 0xea, 0xbb, 0x73, 0xb7, 0xc6, 0xd0, 0x9c, 0xb0, 0xdd, 0x56, 0x30, 0xfb, 0xa6, 0x58, 0x97,
-	0x62, 0x71, 0x89, 0xb3, 0x6f, 0x7a, 0x0e, 0xa8, 0x84, 0xd8, 0xa1, 0x6c, 0xce, 0x7c, 0x97, 0xed,
-	0xb3, 0x86, 0x33, 0x08, 0x5d, 0x9b, 0x51, 0xf4, 0xf0, 0x64, 0xe7, 0x77, 0x0d, 0x2f, 0x80, 0x94,
-	0xab, 0x4c, 0xa6, 0x33, 0xb6, 0xc5, 0x84, 0x4b, 0x01, 0x24, 0x42, 0x95, 0x5f, 0xb9, 0x71, 0x63,
-	0xe0, 0x84, 0xa4, 0xab, 0x32, 0xf3, 0x71, 0xe0, 0x21, 0xbe, 0x34, 0x17, 0x08, 0xb5, 0x4b, 0xed,
-	0xc7, 0xec, 0x46, 0xbc, 0xe3, 0x14, 0x58, 0xed, 0xea, 0xad, 0x3b, 0xba, 0x5a, 0xe9, 0x9f, 0xe9,
-	0xb1, 0x44, 0x33, 0xb6, 0x91, 0x02, 0x34, 0x6a, 0x23, 0xcf, 0xe1, 0x85, 0xd9, 0xc4, 0x31, 0x41,
-	0xc7, 0x19, 0x9a, 0x1a, 0x73, 0x72, 0x66, 0x46, 0xd6, 0x95, 0xd8, 0x66, 0x5e, 0x67, 0x21, 0x7a,
-	0xc1, 0x8c, 0x42, 0x1a, 0x39, 0x3d, 0x70, 0xfc, 0xc0, 0x89, 0x6e, 0x45, 0x81, 0x19, 0x58, 0x42,
-	0x63, 0x39, 0x23, 0x2b, 0xb7, 0x9d, 0xc8, 0x19, 0x4b, 0x72, 0xbd, 0xbc, 0x3d, 0x14, 0xcb, 0xf5,
-	0x56, 0xec, 0xf5, 0xf2, 0xf6, 0x1e, 0x25, 0x72, 0x39, 0x7b, 0x49, 0x3e, 0x75, 0x5d, 0xf7, 0x65,
-	0xf1, 0x71, 0x26, 0x9f, 0x0c, 0xa1, 0x3d, 0xdd, 0xf5, 0xbd, 0x4b, 0x67, 0x32, 0x0f, 0xc8, 0x99,
-	0x69, 0x25, 0x55, 0xb5, 0xc3, 0x04, 0xd7, 0xb1, 0xd0, 0x0f, 0xb0, 0x2d, 0x7b, 0xe6, 0x85, 0x4b,
-	0xb1, 0x01, 0x31, 0x03, 0xcf, 0xf1, 0x26, 0xe2, 0x2e, 0x93, 0x5f, 0x65, 0x48, 0x26, 0x3c, 0xd6,
-	0xd4, 0xd1, 0x30, 0x30, 0x2f, 0x2f, 0x1d, 0xcb, 0xb0, 0xae, 0x88, 0x3d, 0x77, 0x49, 0x10, 0x22,
-	0x05, 0xda, 0x11, 0x05, 0x17, 0x10, 0x2b, 0xf1, 0xc6, 0xeb, 0xa7, 0x47, 0xb9, 0xb1, 0x75, 0x45,
-	0x13, 0x2f, 0xeb, 0x49, 0x87, 0x50, 0xd6, 0xd4, 0x51, 0x88, 0x0e, 0xa0, 0xe2, 0x44, 0x64, 0x9a,
-	0xdc, 0x36, 0xc9, 0xa9, 0xa1, 0xa9, 0x23, 0x1c, 0x33, 0xa4, 0x9f, 0xa0, 0x66, 0x24, 0x8d, 0xf8,
-	0x2c, 0x2f, 0xbd, 0xdc, 0xb1, 0x5c, 0xe3, 0x10, 0xca, 0x23, 0x55, 0xb9, 0xd3, 0x36, 0x3d, 0x91,
-	0x52, 0xdb, 0x40, 0x57, 0x22, 0x9f, 0xe6, 0x24, 0x8c, 0x1e, 0x32, 0xcc, 0x49, 0x2f, 0x00, 0x74,
-	0x4d, 0x4d, 0x34, 0x72, 0x97, 0x79, 0x61, 0xe9, 0x32, 0x97, 0x06, 0x00, 0x74, 0x2d, 0x2e, 0x7b,
-	0x08, 0x6d, 0xda, 0xd5, 0xab, 0x0b, 0x2c, 0xc3, 0x49, 0x59, 0xf7, 0xf8, 0x31, 0x11, 0x13, 0xd2,
-	0x7f, 0x4a, 0x50, 0xef, 0xb8, 0x66, 0x30, 0x65, 0xc7, 0xcd, 0xbf, 0x4a, 0x50, 0xa1, 0x1f, 0x21,
-	0xaa, 0x42, 0x69, 0xa0, 0x19, 0xc2, 0x06, 0x6a, 0x01, 0xf4, 0x3e, 0x2a, 0x6a, 0x7f, 0xdc, 0xef,
-	0x18, 0xba, 0x50, 0x40, 0x4d, 0xa8, 0x6b, 0xea, 0x68, 0xdc, 0x19, 0x74, 0xf0, 0x99, 0x50, 0x44,
-	0x5f, 0xc1, 0x23, 0x4a, 0x1a, 0xc3, 0x0e, 0x1e, 0x8e, 0xf4, 0xf1, 0x49, 0x47, 0x19, 0x8c, 0xb0,
-	0x2c, 0x94, 0xd0, 0x2e, 0x20, 0xc6, 0x50, 0xfa, 0x6a, 0x67, 0x30, 0xee, 0xc9, 0x7d, 0xdc, 0xe9,
-	0xc9, 0x42, 0x39, 0x51, 0xe8, 0x61, 0xe5, 0x64, 0x38, 0xd6, 0x4e, 0xc6, 0x1f, 0x14, 0xb5, 0xa7,
-	0x7d, 0x10, 0x2a, 0x68, 0x1f, 0x44, 0xca, 0x18, 0x68, 0x86, 0x41, 0x71, 0xed, 0xac, 0xab, 0x8c,
-	0xbb, 0xef, 0x3b, 0xaa, 0x2a, 0x0f, 0x84, 0xcd, 0x74, 0x1d, 0x66, 0xce, 0x48, 0xd7, 0xa9, 0xa2,
-	0xe7, 0xf0, 0x1d, 0x65, 0x0c, 0x71, 0x47, 0x35, 0xce, 0x14, 0xc3, 0x50, 0x34, 0x75, 0xac, 0xa8,
-	0x43, 0x19, 0x9f, 0xc8, 0x58, 0x56, 0xbb, 0xf2, 0xf8, 0x43, 0x07, 0xab, 0x8a, 0xda, 0x17, 0x6a,
-	0x68, 0x0f, 0x76, 0x99, 0xeb, 0xdd, 0xa1, 0x72, 0xde, 0x19, 0x52, 0xc1, 0xc4, 0x4c, 0x1d, 0x89,
-	0xac, 0x38, 0xc7, 0x3a, 0xd6, 0xba, 0xb2, 0x61, 0xd0, 0xfd, 0xca, 0x18, 0x6b, 0x58, 0x00, 0x74,
-	0x00, 0xfb, 0x59, 0xbf, 0x4e, 0xe5, 0x8f, 0x63, 0xe3, 0xa3, 0xda, 0x4d, 0x75, 0x1b, 0x68, 0x07,
-	0xb6, 0xa9, 0x84, 0x32, 0x1c, 0x8d, 0x75, 0x4d, 0xa5, 0xb1, 0x18, 0x1a, 0xc2, 0x16, 0xda, 0x86,
-	0x66, 0x1a, 0x29, 0xaa, 0x2e, 0x34, 0x97, 0xa1, 0x63, 0xa1, 0x95, 0x6c, 0x2c, 0x81, 0xf4, 0xee,
-	0x98, 0xee, 0x42, 0x68, 0x27, 0xf1, 0xc8, 0x31, 0xba, 0xdc, 0x2b, 0x01, 0x21, 0x68, 0x65, 0xb9,
-	0x27, 0x8a, 0xb0, 0x8d, 0x1e, 0x41, 0x3b, 0x8b, 0x75, 0xce, 0x14, 0x01, 0x49, 0x6f, 0xa1, 0xc5,
-	0xf2, 0xab, 0x9b, 0x81, 0x39, 0x25, 0x11, 0x09, 0x90, 0x00, 0xa5, 0x53, 0x72, 0xcb, 0xcb, 0x84,
-	0x7e, 0xd2, 0xd2, 0x38, 0x37, 0xdd, 0x79, 0xf2, 0x38, 0x88, 0x09, 0xe9, 0xef, 0x05, 0x66, 0x8f,
-	0x69, 0x67, 0x4a, 0x33, 0xad, 0x16, 0x6e, 0x61, 0x01, 0x3c, 0x68, 0x2a, 0xdb, 0x85, 0x4d, 0x7a,
-	0xcc, 0xce, 0x43, 0x7e, 0x29, 0x71, 0x0a, 0xfd, 0x0c, 0x90, 0xba, 0x18, 0x8a, 0x65, 0xd6, 0x5b,
-	0x3b, 0xbc, 0xb7, 0xf2, 0x1b, 0xc0, 0x19, 0x41, 0xe9, 0x13, 0xb4, 0xb5, 0xc1, 0x30, 0xe7, 0xe3,
-	0x01, 0x34, 0xd8, 0x71, 0x7f, 0x69, 0x5a, 0x84, 0x8f, 0x04, 0x4d, 0x9c, 0x85, 0xd2, 0x5b, 0x82,
-	0x92, 0x6c, 0x27, 0xc5, 0xcc, 0x2d, 0x91, 0x80, 0x77, 0x79, 0x4a, 0x1f, 0x08, 0xcd, 0x73, 0x12,
-	0x84, 0x8e, 0xef, 0xf1, 0x3d, 0x89, 0x50, 0xbd, 0x8e, 0x01, 0x1e, 0x93, 0x84, 0xa4, 0xf1, 0xba,
-	0x98, 0x3b, 0xae, 0x3d, 0x74, 0xa6, 0xe9, 0xd3, 0x2b, 0x05, 0xe8, 0x89, 0x6c, 0xb1, 0xa9, 0xe7,
-	0xbd, 0x19, 0x5e, 0xf1, 0x55, 0x32, 0x08, 0xd5, 0x9e, 0x38, 0x11, 0x77, 0x22, 0x9e, 0x4f, 0x17,
-	0x80, 0xf4, 0x16, 0x6a, 0x03, 0x7f, 0x32, 0x20, 0xd7, 0xc4, 0xa5, 0x19, 0x74, 0xe9, 0x07, 0x5f,
-	0x3f, 0x26, 0xe8, 0x0e, 0x2c, 0xd3, 0x75, 0x79, 0x26, 0x6a, 0x98, 0x53, 0x92, 0x0c, 0x35, 0x4c,
-	0xc2, 0x99, 0xef, 0x85, 0x04, 0x3d, 0x85, 0x46, 0xc8, 0xec, 0x8d, 0x2d, 0xdf, 0x26, 0x7c, 0x80,
-	0x82, 0x18, 0xea, 0xfa, 0x36, 0xa1, 0x9b, 0x9b, 0x92, 0x30, 0x34, 0x27, 0xc9, 0x06, 0x12, 0x52,
-	0xfa, 0x5b, 0x01, 0x1a, 0xf4, 0x36, 0x4f, 0x02, 0xff, 0x1c, 0x36, 0x35, 0x6f, 0x8e, 0xc9, 0x27,
-	0x7e, 0x7e, 0x6f, 0x67, 0x8e, 0xdd, 0x58, 0x04, 0x73, 0x01, 0xf4, 0x0e, 0xb6, 0x8c, 0xf9, 0x45,
-	0x87, 0x4d, 0x93, 0xe7, 0xa6, 0xcb, 0x2c, 0xb7, 0xd2, 0x7c, 0xa7, 0x2c, 0x76, 0x16, 0xe1, 0x9c,
-	0x28, 0x2d, 0xb2, 0x7e, 0xe0, 0xcf, 0x67, 0xc9, 0xfd, 0x14, 0x87, 0x2d, 0x87, 0x49, 0xa7, 0x50,
-	0xa1, 0x8f, 0x92, 0x10, 0x7d, 0x0d, 0x70, 0xe9, 0xfa, 0x37, 0x63, 0x8b, 0xfd, 0x06, 0xc1, 0xcf,
-	0x52, 0x8a, 0xc4, 0xbf, 0x40, 0x7c, 0x0b, 0x15, 0x4a, 0xd0, 0xd1, 0x89, 0xd6, 0x5b, 0xf3, 0x28,
-	0xf9, 0x11, 0x85, 0x6a, 0xe3, 0x98, 0x27, 0x3d, 0x85, 0x2a, 0xcd, 0x96, 0x3f, 0x8f, 0x68, 0x98,
-	0x6d, 0xe2, 0x9a, 0xb7, 0xdc, 0x52, 0x4c, 0x48, 0x55, 0xa8, 0xc8, 0xd3, 0x59, 0x74, 0xfb, 0x62,
-	0x1f, 0xaa, 0x7c, 0x7c, 0xa3, 0xe7, 0xa7, 0x3c, 0x7c, 0x2f, 0x6c, 0xa0, 0x1a, 0x94, 0x75, 0x6d,
-	0x68, 0x08, 0x85, 0x17, 0xaf, 0xa0, 0x95, 0xdf, 0x18, 0xe5, 0xfd, 0xaa, 0x29, 0xf4, 0x21, 0x58,
-	0x87, 0xca, 0x40, 0xee, 0x9c, 0xcb, 0x42, 0x01, 0x01, 0x6c, 0x52, 0xf0, 0xfc, 0x8d, 0x50, 0x7c,
-	0xfd, 0xdb, 0x16, 0x54, 0x8e, 0x8f, 0x0d, 0x67, 0x8a, 0x5e, 0x42, 0x95, 0xd7, 0x1c, 0xda, 0xe2,
-	0x51, 0x62, 0x6b, 0xee, 0x3d, 0xe6, 0x54, 0xae, 0x22, 0xa5, 0x0d, 0xf4, 0x0a, 0x1a, 0x06, 0x89,
-	0xd2, 0x02, 0x69, 0x73, 0xb1, 0x04, 0xd8, 0x5b, 0x06, 0xa4, 0x0d, 0xf4, 0x0c, 0x36, 0xfb, 0x24,
-	0xd2, 0xdc, 0x68, 0x69, 0x09, 0x58, 0x3c, 0xd6, 0xa4, 0x0d, 0xf4, 0x47, 0x10, 0x63, 0xa9, 0x35,
-	0x2f, 0xbd, 0xa7, 0x9f, 0x79, 0x10, 0xef, 0xed, 0xdf, 0x23, 0x10, 0x4a, 0x1b, 0xe8, 0x47, 0x00,
-	0xdd, 0xbf, 0x21, 0x81, 0xef, 0xad, 0xba, 0x91, 0x78, 0x9c, 0x94, 0xae, 0xb4, 0x81, 0x8e, 0xa0,
-	0x61, 0x5c, 0xcd, 0x23, 0xdb, 0xbf, 0x79, 0x98, 0xfc, 0x0f, 0x50, 0xc7, 0xe4, 0xc2, 0xf7, 0xa3,
-	0x07, 0x49, 0xd3, 0x84, 0x45, 0xfe, 0x6c, 0x82, 0xf5, 0x2e, 0x9d, 0x05, 0x48, 0xf0, 0x79, 0x95,
-	0xd7, 0xd0, 0x36, 0x22, 0x33, 0x88, 0xbe, 0x44, 0xe7, 0x17, 0xd8, 0xc6, 0x24, 0x5c, 0xd2, 0x4a,
-	0x86, 0x10, 0x5e, 0x79, 0xeb, 0xf4, 0x9e, 0xc7, 0xe9, 0x52, 0x47, 0x68, 0xb5, 0xd1, 0xf6, 0x32,
-	0x23, 0x8f, 0xb4, 0x81, 0xbe, 0xa7, 0xe3, 0x76, 0xc4, 0x46, 0xa3, 0xbc, 0x3b, 0x8d, 0x85, 0x18,
-	0x0d, 0xff, 0x1b, 0x68, 0xf4, 0x49, 0x94, 0x0e, 0x46, 0xdb, 0x99, 0xd9, 0x86, 0xdb, 0x6d, 0xe7,
-	0x87, 0xa3, 0x90, 0x25, 0x01, 0xa8, 0x71, 0x6f, 0x3e, 0xf2, 0x9c, 0x70, 0x9d, 0x2f, 0x8d, 0x85,
-	0x19, 0xbe, 0x48, 0x9a, 0xb4, 0xf5, 0xce, 0xdf, 0x11, 0x58, 0xae, 0xd4, 0x71, 0xdd, 0x35, 0x3b,
-	0x59, 0xa3, 0xf3, 0x0e, 0xb6, 0x33, 0x0b, 0x85, 0x9a, 0xa7, 0x6b, 0x6a, 0xba, 0xdc, 0x62, 0xde,
-	0x5a, 0xbf, 0x5c, 0x5a, 0x87, 0x0f, 0x76, 0xf1, 0x15, 0xb4, 0xb8, 0xce, 0x83, 0x3d, 0x7c, 0x0b,
-	0xc2, 0x62, 0x99, 0x2f, 0x72, 0xf0, 0x77, 0xb0, 0xc5, 0x8b, 0x26, 0x7e, 0x8c, 0xdd, 0x93, 0xaa,
-	0x8c, 0xd6, 0x1b, 0x68, 0x70, 0x2d, 0xf6, 0x46, 0x7b, 0x98, 0xd2, 0x31, 0xec, 0x18, 0x2c, 0xbf,
-	0xec, 0x96, 0x55, 0x3c, 0xdb, 0xb1, 0x4c, 0x7a, 0x86, 0xa1, 0xdd, 0x45, 0x58, 0xb2, 0x17, 0xf0,
-	0x3d, 0x36, 0x68, 0xd7, 0xdf, 0x61, 0x23, 0x7f, 0x89, 0xaf, 0xb3, 0xf1, 0x23, 0xd4, 0xfa, 0x24,
-	0x8a, 0xcf, 0xf5, 0x35, 0x19, 0x49, 0x82, 0xcd, 0x04, 0x58, 0x6c, 0xdb, 0xdd, 0x2b, 0xd3, 0x9b,
-	0x10, 0x7a, 0x45, 0xc5, 0x2f, 0x3b, 0xc4, 0x45, 0x32, 0x97, 0xd6, 0xba, 0x85, 0x4e, 0xe1, 0xab,
-	0xb8, 0xa0, 0x57, 0xdf, 0x2a, 0x6b, 0xd6, 0x7d, 0xb2, 0x80, 0x56, 0xe4, 0xd3, 0xd6, 0x63, 0xad,
-	0xb1, 0xbe, 0xf5, 0x78, 0x57, 0xfc, 0x4c, 0x67, 0x92, 0x6b, 0xd3, 0x75, 0x6c, 0x33, 0x22, 0x67,
-	0x76, 0xf8, 0xb0, 0xa2, 0x3b, 0xfe, 0xee, 0x0f, 0xdf, 0x4e, 0x9c, 0xe8, 0x6a, 0x7e, 0x71, 0x64,
-	0xf9, 0x53, 0xf6, 0x3f, 0x00, 0xcb, 0x0f, 0x6c, 0xfe, 0x4f, 0x83, 0xf4, 0xdf, 0x07, 0x17, 0x9b,
-	0xec, 0x37, 0xff, 0x37, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x75, 0xfb, 0x84, 0x52, 0x18,
-	0x00, 0x00,
+type NNIFlapRequest struct {
+	NniPortId uint32 `protobuf:"varint,1,opt,name=NniPortId,proto3" json:"NniPortId,omitempty"`
+	// how long the NNI stays operationally down before coming back up
+	DownDurationMs       uint32   `protobuf:"varint,2,opt,name=DownDurationMs,proto3" json:"DownDurationMs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *NNIFlapRequest) Reset()         { *m = NNIFlapRequest{} }
+func (m *NNIFlapRequest) String() string { return proto.CompactTextString(m) }
+func (*NNIFlapRequest) ProtoMessage()    {}
 
-// BBSimClient is the client API for BBSim service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type BBSimClient interface {
-	// Get BBSim version
-	Version(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*VersionNumber, error)
-	// Set BBSim log level
-	SetLogLevel(ctx context.Context, in *LogLevel, opts ...grpc.CallOption) (*LogLevel, error)
-	// Get current status of OLT
-	GetOlt(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Olt, error)
-	GetOltAllocatedResources(ctx context.Context, in *OltAllocatedResourceType, opts ...grpc.CallOption) (*OltAllocatedResources, error)
-	// Poweron OLT
-	PoweronOlt(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error)
-	// Shutdown OLT
-	ShutdownOlt(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error)
-	// Reboot OLT
-	RebootOlt(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error)
-	// Closes the Openolt gRPC server
-	StopgRPCServer(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error)
-	// Start the Openolt gRPC server
-	StartgRPCServer(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error)
-	// Restart the Openolt gRPC server after the given timeout
-	RestartgRPCServer(ctx context.Context, in *Timeout, opts ...grpc.CallOption) (*Response, error)
-	// Get status of an ONU by serial number
-	GetONU(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*ONU, error)
-	// Get status of all ONUs
-	GetONUs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ONUs, error)
-	// Get all the Services by Uni ID
-	GetServices(ctx context.Context, in *UNIRequest, opts ...grpc.CallOption) (*Services, error)
-	// Get all the UNIs of an ONU by serial number
-	GetOnuUnis(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*UNIs, error)
-	// Shutdown an ONU by serial number
-	ShutdownONU(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Response, error)
-	// Shutdown all ONUs in OLT
-	ShutdownAllONUs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error)
-	// Shutdown all ONUs under a PON by pon-port-ID
-	ShutdownONUsOnPON(ctx context.Context, in *PONRequest, opts ...grpc.CallOption) (*Response, error)
-	// Poweron an ONU by serial number
-	PoweronONU(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Response, error)
-	// Poweron all ONUs in OLT
-	PoweronAllONUs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error)
-	// Poweron all ONUs under a PON by pon-port-ID
-	PoweronONUsOnPON(ctx context.Context, in *PONRequest, opts ...grpc.CallOption) (*Response, error)
-	// Restart EAPOL for ONU
-	RestartEapol(ctx context.Context, in *UNIRequest, opts ...grpc.CallOption) (*Response, error)
-	// Restart DHCP for ONU
-	RestartDhcp(ctx context.Context, in *UNIRequest, opts ...grpc.CallOption) (*Response, error)
-	// Send ONU alarm indication
-	SetOnuAlarmIndication(ctx context.Context, in *ONUAlarmRequest, opts ...grpc.CallOption) (*Response, error)
-	// Send OLT alarm indication for Interface type NNI or PON
-	SetOltAlarmIndication(ctx context.Context, in *OLTAlarmRequest, opts ...grpc.CallOption) (*Response, error)
-	// Get all flows or ONU specific flows
-	GetFlows(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Flows, error)
-	// Change IGMP state
-	ChangeIgmpState(ctx context.Context, in *IgmpRequest, opts ...grpc.CallOption) (*Response, error)
-	// Get Traffic scheduler information for ONU
-	GetOnuTrafficSchedulers(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*ONUTrafficSchedulers, error)
-	//Return all the UNIs for all the ONUs
-	GetUnis(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*UNIs, error)
-	//Invalidate the MDS counter for the ONU
-	InvalidateMds(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Response, error)
+func (m *NNIFlapRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NNIFlapRequest.Unmarshal(m, b)
 }
-
-type bBSimClient struct {
-	cc *grpc.ClientConn
+func (m *NNIFlapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NNIFlapRequest.Marshal(b, m, deterministic)
 }
-
-func NewBBSimClient(cc *grpc.ClientConn) BBSimClient {
-	return &bBSimClient{cc}
+func (m *NNIFlapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NNIFlapRequest.Merge(m, src)
+}
+func (m *NNIFlapRequest) XXX_Size() int {
+	return xxx_messageInfo_NNIFlapRequest.Size(m)
+}
+func (m *NNIFlapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NNIFlapRequest.DiscardUnknown(m)
 }
 
-func (c *bBSimClient) Version(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*VersionNumber, error) {
-	out := new(VersionNumber)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/Version", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_NNIFlapRequest proto.InternalMessageInfo
+
+func (m *NNIFlapRequest) GetNniPortId() uint32 {
+	if m != nil {
+		return m.NniPortId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *bBSimClient) SetLogLevel(ctx context.Context, in *LogLevel, opts ...grpc.CallOption) (*LogLevel, error) {
-	out := new(LogLevel)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/SetLogLevel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *NNIFlapRequest) GetDownDurationMs() uint32 {
+	if m != nil {
+		return m.DownDurationMs
 	}
-	return out, nil
+	return 0
 }
 
-func (c *bBSimClient) GetOlt(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Olt, error) {
-	out := new(Olt)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetOlt", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type AdvanceTodRequest struct {
+	// how many seconds to add to the simulated time-of-day
+	Seconds              uint32   `protobuf:"varint,1,opt,name=Seconds,proto3" json:"Seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *bBSimClient) GetOltAllocatedResources(ctx context.Context, in *OltAllocatedResourceType, opts ...grpc.CallOption) (*OltAllocatedResources, error) {
-	out := new(OltAllocatedResources)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetOltAllocatedResources", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AdvanceTodRequest) Reset()         { *m = AdvanceTodRequest{} }
+func (m *AdvanceTodRequest) String() string { return proto.CompactTextString(m) }
+func (*AdvanceTodRequest) ProtoMessage()    {}
+
+func (m *AdvanceTodRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdvanceTodRequest.Unmarshal(m, b)
+}
+func (m *AdvanceTodRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdvanceTodRequest.Marshal(b, m, deterministic)
+}
+func (m *AdvanceTodRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdvanceTodRequest.Merge(m, src)
+}
+func (m *AdvanceTodRequest) XXX_Size() int {
+	return xxx_messageInfo_AdvanceTodRequest.Size(m)
+}
+func (m *AdvanceTodRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdvanceTodRequest.DiscardUnknown(m)
 }
 
-func (c *bBSimClient) PoweronOlt(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/PoweronOlt", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AdvanceTodRequest proto.InternalMessageInfo
+
+func (m *AdvanceTodRequest) GetSeconds() uint32 {
+	if m != nil {
+		return m.Seconds
 	}
-	return out, nil
+	return 0
 }
 
-func (c *bBSimClient) ShutdownOlt(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/ShutdownOlt", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ClearFlowsRequest struct {
+	// when set only the flows of this ONU are cleared, otherwise all the
+	// flows on PonPortId are
+	OnuSerialNumber      string   `protobuf:"bytes,1,opt,name=OnuSerialNumber,proto3" json:"OnuSerialNumber,omitempty"`
+	PonPortId            uint32   `protobuf:"varint,2,opt,name=PonPortId,proto3" json:"PonPortId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *bBSimClient) RebootOlt(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/RebootOlt", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ClearFlowsRequest) Reset()         { *m = ClearFlowsRequest{} }
+func (m *ClearFlowsRequest) String() string { return proto.CompactTextString(m) }
+func (*ClearFlowsRequest) ProtoMessage()    {}
+
+func (m *ClearFlowsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClearFlowsRequest.Unmarshal(m, b)
+}
+func (m *ClearFlowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClearFlowsRequest.Marshal(b, m, deterministic)
+}
+func (m *ClearFlowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearFlowsRequest.Merge(m, src)
+}
+func (m *ClearFlowsRequest) XXX_Size() int {
+	return xxx_messageInfo_ClearFlowsRequest.Size(m)
+}
+func (m *ClearFlowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearFlowsRequest.DiscardUnknown(m)
 }
 
-func (c *bBSimClient) StopgRPCServer(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/StopgRPCServer", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ClearFlowsRequest proto.InternalMessageInfo
+
+func (m *ClearFlowsRequest) GetOnuSerialNumber() string {
+	if m != nil {
+		return m.OnuSerialNumber
 	}
-	return out, nil
+	return ""
 }
 
-func (c *bBSimClient) StartgRPCServer(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/StartgRPCServer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ClearFlowsRequest) GetPonPortId() uint32 {
+	if m != nil {
+		return m.PonPortId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *bBSimClient) RestartgRPCServer(ctx context.Context, in *Timeout, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/RestartgRPCServer", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type PonTechnologyRequest struct {
+	PonPortId uint32 `protobuf:"varint,1,opt,name=PonPortId,proto3" json:"PonPortId,omitempty"`
+	// one of GPON, XGS-PON, 25G-PON
+	Technology           string   `protobuf:"bytes,2,opt,name=Technology,proto3" json:"Technology,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *bBSimClient) GetONU(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*ONU, error) {
-	out := new(ONU)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetONU", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *PonTechnologyRequest) Reset()         { *m = PonTechnologyRequest{} }
+func (m *PonTechnologyRequest) String() string { return proto.CompactTextString(m) }
+func (*PonTechnologyRequest) ProtoMessage()    {}
+
+func (m *PonTechnologyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PonTechnologyRequest.Unmarshal(m, b)
+}
+func (m *PonTechnologyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PonTechnologyRequest.Marshal(b, m, deterministic)
+}
+func (m *PonTechnologyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PonTechnologyRequest.Merge(m, src)
+}
+func (m *PonTechnologyRequest) XXX_Size() int {
+	return xxx_messageInfo_PonTechnologyRequest.Size(m)
+}
+func (m *PonTechnologyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PonTechnologyRequest.DiscardUnknown(m)
 }
 
-func (c *bBSimClient) GetONUs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ONUs, error) {
-	out := new(ONUs)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetONUs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PonTechnologyRequest proto.InternalMessageInfo
+
+func (m *PonTechnologyRequest) GetPonPortId() uint32 {
+	if m != nil {
+		return m.PonPortId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *bBSimClient) GetServices(ctx context.Context, in *UNIRequest, opts ...grpc.CallOption) (*Services, error) {
-	out := new(Services)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetServices", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PonTechnologyRequest) GetTechnology() string {
+	if m != nil {
+		return m.Technology
 	}
-	return out, nil
+	return ""
 }
 
-func (c *bBSimClient) GetOnuUnis(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*UNIs, error) {
-	out := new(UNIs)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetOnuUnis", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type OnuServiceRequest struct {
+	OnuSerialNumber string `protobuf:"bytes,1,opt,name=OnuSerialNumber,proto3" json:"OnuSerialNumber,omitempty"`
+	UniId           uint32 `protobuf:"varint,2,opt,name=UniId,proto3" json:"UniId,omitempty"`
+	// name of a service defined in the services configuration
+	ServiceName string `protobuf:"bytes,3,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	// tags to assign, 0 picks the configured default for the service
+	CTag                 int32    `protobuf:"varint,4,opt,name=CTag,proto3" json:"CTag,omitempty"`
+	STag                 int32    `protobuf:"varint,5,opt,name=STag,proto3" json:"STag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *bBSimClient) ShutdownONU(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/ShutdownONU", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *OnuServiceRequest) Reset()         { *m = OnuServiceRequest{} }
+func (m *OnuServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*OnuServiceRequest) ProtoMessage()    {}
+
+func (m *OnuServiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OnuServiceRequest.Unmarshal(m, b)
+}
+func (m *OnuServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OnuServiceRequest.Marshal(b, m, deterministic)
+}
+func (m *OnuServiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnuServiceRequest.Merge(m, src)
+}
+func (m *OnuServiceRequest) XXX_Size() int {
+	return xxx_messageInfo_OnuServiceRequest.Size(m)
+}
+func (m *OnuServiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnuServiceRequest.DiscardUnknown(m)
 }
 
-func (c *bBSimClient) ShutdownAllONUs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/ShutdownAllONUs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OnuServiceRequest proto.InternalMessageInfo
+
+func (m *OnuServiceRequest) GetOnuSerialNumber() string {
+	if m != nil {
+		return m.OnuSerialNumber
 	}
-	return out, nil
+	return ""
 }
 
-func (c *bBSimClient) ShutdownONUsOnPON(ctx context.Context, in *PONRequest, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/ShutdownONUsOnPON", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OnuServiceRequest) GetUniId() uint32 {
+	if m != nil {
+		return m.UniId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *bBSimClient) PoweronONU(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/PoweronONU", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OnuServiceRequest) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *bBSimClient) PoweronAllONUs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/PoweronAllONUs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OnuServiceRequest) GetCTag() int32 {
+	if m != nil {
+		return m.CTag
 	}
-	return out, nil
+	return 0
 }
 
-func (c *bBSimClient) PoweronONUsOnPON(ctx context.Context, in *PONRequest, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/PoweronONUsOnPON", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OnuServiceRequest) GetSTag() int32 {
+	if m != nil {
+		return m.STag
 	}
-	return out, nil
+	return 0
 }
 
-func (c *bBSimClient) RestartEapol(ctx context.Context, in *UNIRequest, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/RestartEapol", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type BipErrorRequest struct {
+	// interface ID as reported in the port stats indication
+	IntfId               uint32   `protobuf:"varint,1,opt,name=IntfId,proto3" json:"IntfId,omitempty"`
+	BipErrors            uint64   `protobuf:"varint,2,opt,name=BipErrors,proto3" json:"BipErrors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *bBSimClient) RestartDhcp(ctx context.Context, in *UNIRequest, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/RestartDhcp", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *BipErrorRequest) Reset()         { *m = BipErrorRequest{} }
+func (m *BipErrorRequest) String() string { return proto.CompactTextString(m) }
+func (*BipErrorRequest) ProtoMessage()    {}
+
+func (m *BipErrorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BipErrorRequest.Unmarshal(m, b)
+}
+func (m *BipErrorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BipErrorRequest.Marshal(b, m, deterministic)
+}
+func (m *BipErrorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BipErrorRequest.Merge(m, src)
+}
+func (m *BipErrorRequest) XXX_Size() int {
+	return xxx_messageInfo_BipErrorRequest.Size(m)
+}
+func (m *BipErrorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BipErrorRequest.DiscardUnknown(m)
 }
 
-func (c *bBSimClient) SetOnuAlarmIndication(ctx context.Context, in *ONUAlarmRequest, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/SetOnuAlarmIndication", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BipErrorRequest proto.InternalMessageInfo
+
+func (m *BipErrorRequest) GetIntfId() uint32 {
+	if m != nil {
+		return m.IntfId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *bBSimClient) SetOltAlarmIndication(ctx context.Context, in *OLTAlarmRequest, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/SetOltAlarmIndication", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BipErrorRequest) GetBipErrors() uint64 {
+	if m != nil {
+		return m.BipErrors
 	}
-	return out, nil
+	return 0
 }
 
-func (c *bBSimClient) GetFlows(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Flows, error) {
-	out := new(Flows)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetFlows", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type SignatureRequest struct {
+	Signature            uint32   `protobuf:"varint,1,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *bBSimClient) ChangeIgmpState(ctx context.Context, in *IgmpRequest, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/ChangeIgmpState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *SignatureRequest) Reset()         { *m = SignatureRequest{} }
+func (m *SignatureRequest) String() string { return proto.CompactTextString(m) }
+func (*SignatureRequest) ProtoMessage()    {}
+
+func (m *SignatureRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignatureRequest.Unmarshal(m, b)
+}
+func (m *SignatureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignatureRequest.Marshal(b, m, deterministic)
+}
+func (m *SignatureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignatureRequest.Merge(m, src)
+}
+func (m *SignatureRequest) XXX_Size() int {
+	return xxx_messageInfo_SignatureRequest.Size(m)
+}
+func (m *SignatureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignatureRequest.DiscardUnknown(m)
 }
 
-func (c *bBSimClient) GetOnuTrafficSchedulers(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*ONUTrafficSchedulers, error) {
-	out := new(ONUTrafficSchedulers)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetOnuTrafficSchedulers", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SignatureRequest proto.InternalMessageInfo
+
+func (m *SignatureRequest) GetSignature() uint32 {
+	if m != nil {
+		return m.Signature
 	}
-	return out, nil
+	return 0
 }
 
-func (c *bBSimClient) GetUnis(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*UNIs, error) {
-	out := new(UNIs)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetUnis", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type Empty struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *bBSimClient) InvalidateMds(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/bbsim.BBSim/InvalidateMds", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *Empty) Reset()         { *m = Empty{} }
+func (m *Empty) String() string { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()    {}
+func (*Empty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef7750073d18011b, []int{27}
 }
 
-// BBSimServer is the server API for BBSim service.
-type BBSimServer interface {
-	// Get BBSim version
-	Version(context.Context, *Empty) (*VersionNumber, error)
-	// Set BBSim log level
-	SetLogLevel(context.Context, *LogLevel) (*LogLevel, error)
-	// Get current status of OLT
-	GetOlt(context.Context, *Empty) (*Olt, error)
-	GetOltAllocatedResources(context.Context, *OltAllocatedResourceType) (*OltAllocatedResources, error)
-	// Poweron OLT
-	PoweronOlt(context.Context, *Empty) (*Response, error)
-	// Shutdown OLT
-	ShutdownOlt(context.Context, *Empty) (*Response, error)
-	// Reboot OLT
-	RebootOlt(context.Context, *Empty) (*Response, error)
-	// Closes the Openolt gRPC server
-	StopgRPCServer(context.Context, *Empty) (*Response, error)
-	// Start the Openolt gRPC server
-	StartgRPCServer(context.Context, *Empty) (*Response, error)
-	// Restart the Openolt gRPC server after the given timeout
-	RestartgRPCServer(context.Context, *Timeout) (*Response, error)
-	// Get status of an ONU by serial number
-	GetONU(context.Context, *ONURequest) (*ONU, error)
-	// Get status of all ONUs
-	GetONUs(context.Context, *Empty) (*ONUs, error)
-	// Get all the Services by Uni ID
-	GetServices(context.Context, *UNIRequest) (*Services, error)
-	// Get all the UNIs of an ONU by serial number
-	GetOnuUnis(context.Context, *ONURequest) (*UNIs, error)
-	// Shutdown an ONU by serial number
-	ShutdownONU(context.Context, *ONURequest) (*Response, error)
-	// Shutdown all ONUs in OLT
-	ShutdownAllONUs(context.Context, *Empty) (*Response, error)
-	// Shutdown all ONUs under a PON by pon-port-ID
-	ShutdownONUsOnPON(context.Context, *PONRequest) (*Response, error)
-	// Poweron an ONU by serial number
-	PoweronONU(context.Context, *ONURequest) (*Response, error)
-	// Poweron all ONUs in OLT
-	PoweronAllONUs(context.Context, *Empty) (*Response, error)
-	// Poweron all ONUs under a PON by pon-port-ID
-	PoweronONUsOnPON(context.Context, *PONRequest) (*Response, error)
-	// Restart EAPOL for ONU
-	RestartEapol(context.Context, *UNIRequest) (*Response, error)
-	// Restart DHCP for ONU
-	RestartDhcp(context.Context, *UNIRequest) (*Response, error)
-	// Send ONU alarm indication
-	SetOnuAlarmIndication(context.Context, *ONUAlarmRequest) (*Response, error)
-	// Send OLT alarm indication for Interface type NNI or PON
-	SetOltAlarmIndication(context.Context, *OLTAlarmRequest) (*Response, error)
-	// Get all flows or ONU specific flows
-	GetFlows(context.Context, *ONURequest) (*Flows, error)
-	// Change IGMP state
-	ChangeIgmpState(context.Context, *IgmpRequest) (*Response, error)
-	// Get Traffic scheduler information for ONU
-	GetOnuTrafficSchedulers(context.Context, *ONURequest) (*ONUTrafficSchedulers, error)
-	//Return all the UNIs for all the ONUs
-	GetUnis(context.Context, *Empty) (*UNIs, error)
-	//Invalidate the MDS counter for the ONU
-	InvalidateMds(context.Context, *ONURequest) (*Response, error)
+func (m *Empty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Empty.Unmarshal(m, b)
+}
+func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Empty.Marshal(b, m, deterministic)
+}
+func (m *Empty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Empty.Merge(m, src)
+}
+func (m *Empty) XXX_Size() int {
+	return xxx_messageInfo_Empty.Size(m)
+}
+func (m *Empty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Empty.DiscardUnknown(m)
 }
 
-// UnimplementedBBSimServer can be embedded to have forward compatible implementations.
-type UnimplementedBBSimServer struct {
+var xxx_messageInfo_Empty proto.InternalMessageInfo
+
+type OnuIdRequest struct {
+	PonPortId            uint32   `protobuf:"varint,1,opt,name=PonPortId,proto3" json:"PonPortId,omitempty"`
+	OnuId                uint32   `protobuf:"varint,2,opt,name=OnuId,proto3" json:"OnuId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*UnimplementedBBSimServer) Version(ctx context.Context, req *Empty) (*VersionNumber, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+func (m *OnuIdRequest) Reset()         { *m = OnuIdRequest{} }
+func (m *OnuIdRequest) String() string { return proto.CompactTextString(m) }
+func (*OnuIdRequest) ProtoMessage()    {}
+
+func (m *OnuIdRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OnuIdRequest.Unmarshal(m, b)
 }
-func (*UnimplementedBBSimServer) SetLogLevel(ctx context.Context, req *LogLevel) (*LogLevel, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+func (m *OnuIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OnuIdRequest.Marshal(b, m, deterministic)
 }
-func (*UnimplementedBBSimServer) GetOlt(ctx context.Context, req *Empty) (*Olt, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOlt not implemented")
+func (m *OnuIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnuIdRequest.Merge(m, src)
 }
-func (*UnimplementedBBSimServer) GetOltAllocatedResources(ctx context.Context, req *OltAllocatedResourceType) (*OltAllocatedResources, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOltAllocatedResources not implemented")
+func (m *OnuIdRequest) XXX_Size() int {
+	return xxx_messageInfo_OnuIdRequest.Size(m)
 }
-func (*UnimplementedBBSimServer) PoweronOlt(ctx context.Context, req *Empty) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoweronOlt not implemented")
+func (m *OnuIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnuIdRequest.DiscardUnknown(m)
 }
-func (*UnimplementedBBSimServer) ShutdownOlt(ctx context.Context, req *Empty) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShutdownOlt not implemented")
+
+var xxx_messageInfo_OnuIdRequest proto.InternalMessageInfo
+
+func (m *OnuIdRequest) GetPonPortId() uint32 {
+	if m != nil {
+		return m.PonPortId
+	}
+	return 0
 }
-func (*UnimplementedBBSimServer) RebootOlt(ctx context.Context, req *Empty) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RebootOlt not implemented")
+
+func (m *OnuIdRequest) GetOnuId() uint32 {
+	if m != nil {
+		return m.OnuId
+	}
+	return 0
 }
-func (*UnimplementedBBSimServer) StopgRPCServer(ctx context.Context, req *Empty) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopgRPCServer not implemented")
+
+// state of a single ONU as reported in a PonOnuSummary
+type PonOnuStatus struct {
+	OnuId                uint32   `protobuf:"varint,1,opt,name=OnuId,proto3" json:"OnuId,omitempty"`
+	SerialNumber         string   `protobuf:"bytes,2,opt,name=SerialNumber,proto3" json:"SerialNumber,omitempty"`
+	InternalState        string   `protobuf:"bytes,3,opt,name=InternalState,proto3" json:"InternalState,omitempty"`
+	OperState            string   `protobuf:"bytes,4,opt,name=OperState,proto3" json:"OperState,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedBBSimServer) StartgRPCServer(ctx context.Context, req *Empty) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartgRPCServer not implemented")
+
+func (m *PonOnuStatus) Reset()         { *m = PonOnuStatus{} }
+func (m *PonOnuStatus) String() string { return proto.CompactTextString(m) }
+func (*PonOnuStatus) ProtoMessage()    {}
+
+func (m *PonOnuStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PonOnuStatus.Unmarshal(m, b)
 }
-func (*UnimplementedBBSimServer) RestartgRPCServer(ctx context.Context, req *Timeout) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RestartgRPCServer not implemented")
+func (m *PonOnuStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PonOnuStatus.Marshal(b, m, deterministic)
 }
-func (*UnimplementedBBSimServer) GetONU(ctx context.Context, req *ONURequest) (*ONU, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetONU not implemented")
+func (m *PonOnuStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PonOnuStatus.Merge(m, src)
 }
-func (*UnimplementedBBSimServer) GetONUs(ctx context.Context, req *Empty) (*ONUs, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetONUs not implemented")
+func (m *PonOnuStatus) XXX_Size() int {
+	return xxx_messageInfo_PonOnuStatus.Size(m)
 }
-func (*UnimplementedBBSimServer) GetServices(ctx context.Context, req *UNIRequest) (*Services, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetServices not implemented")
+func (m *PonOnuStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PonOnuStatus.DiscardUnknown(m)
 }
-func (*UnimplementedBBSimServer) GetOnuUnis(ctx context.Context, req *ONURequest) (*UNIs, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOnuUnis not implemented")
+
+var xxx_messageInfo_PonOnuStatus proto.InternalMessageInfo
+
+func (m *PonOnuStatus) GetOnuId() uint32 {
+	if m != nil {
+		return m.OnuId
+	}
+	return 0
 }
-func (*UnimplementedBBSimServer) ShutdownONU(ctx context.Context, req *ONURequest) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShutdownONU not implemented")
+
+func (m *PonOnuStatus) GetSerialNumber() string {
+	if m != nil {
+		return m.SerialNumber
+	}
+	return ""
 }
-func (*UnimplementedBBSimServer) ShutdownAllONUs(ctx context.Context, req *Empty) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShutdownAllONUs not implemented")
+
+func (m *PonOnuStatus) GetInternalState() string {
+	if m != nil {
+		return m.InternalState
+	}
+	return ""
 }
-func (*UnimplementedBBSimServer) ShutdownONUsOnPON(ctx context.Context, req *PONRequest) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShutdownONUsOnPON not implemented")
+
+func (m *PonOnuStatus) GetOperState() string {
+	if m != nil {
+		return m.OperState
+	}
+	return ""
 }
-func (*UnimplementedBBSimServer) PoweronONU(ctx context.Context, req *ONURequest) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoweronONU not implemented")
+
+// total and active ONU counts for a PON port together with the state
+// of each ONU
+type PonOnuSummary struct {
+	PonPortId            uint32          `protobuf:"varint,1,opt,name=PonPortId,proto3" json:"PonPortId,omitempty"`
+	TotalOnus            uint32          `protobuf:"varint,2,opt,name=TotalOnus,proto3" json:"TotalOnus,omitempty"`
+	ActiveOnus           uint32          `protobuf:"varint,3,opt,name=ActiveOnus,proto3" json:"ActiveOnus,omitempty"`
+	Onus                 []*PonOnuStatus `protobuf:"bytes,4,rep,name=Onus,proto3" json:"Onus,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PonOnuSummary) Reset()         { *m = PonOnuSummary{} }
+func (m *PonOnuSummary) String() string { return proto.CompactTextString(m) }
+func (*PonOnuSummary) ProtoMessage()    {}
+
+func (m *PonOnuSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PonOnuSummary.Unmarshal(m, b)
+}
+func (m *PonOnuSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PonOnuSummary.Marshal(b, m, deterministic)
+}
+func (m *PonOnuSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PonOnuSummary.Merge(m, src)
+}
+func (m *PonOnuSummary) XXX_Size() int {
+	return xxx_messageInfo_PonOnuSummary.Size(m)
+}
+func (m *PonOnuSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_PonOnuSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PonOnuSummary proto.InternalMessageInfo
+
+func (m *PonOnuSummary) GetPonPortId() uint32 {
+	if m != nil {
+		return m.PonPortId
+	}
+	return 0
+}
+
+func (m *PonOnuSummary) GetTotalOnus() uint32 {
+	if m != nil {
+		return m.TotalOnus
+	}
+	return 0
+}
+
+func (m *PonOnuSummary) GetActiveOnus() uint32 {
+	if m != nil {
+		return m.ActiveOnus
+	}
+	return 0
+}
+
+func (m *PonOnuSummary) GetOnus() []*PonOnuStatus {
+	if m != nil {
+		return m.Onus
+	}
+	return nil
+}
+
+type PonOnuSummaries struct {
+	Pons                 []*PonOnuSummary `protobuf:"bytes,1,rep,name=Pons,proto3" json:"Pons,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PonOnuSummaries) Reset()         { *m = PonOnuSummaries{} }
+func (m *PonOnuSummaries) String() string { return proto.CompactTextString(m) }
+func (*PonOnuSummaries) ProtoMessage()    {}
+
+func (m *PonOnuSummaries) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PonOnuSummaries.Unmarshal(m, b)
+}
+func (m *PonOnuSummaries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PonOnuSummaries.Marshal(b, m, deterministic)
+}
+func (m *PonOnuSummaries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PonOnuSummaries.Merge(m, src)
+}
+func (m *PonOnuSummaries) XXX_Size() int {
+	return xxx_messageInfo_PonOnuSummaries.Size(m)
+}
+func (m *PonOnuSummaries) XXX_DiscardUnknown() {
+	xxx_messageInfo_PonOnuSummaries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PonOnuSummaries proto.InternalMessageInfo
+
+func (m *PonOnuSummaries) GetPons() []*PonOnuSummary {
+	if m != nil {
+		return m.Pons
+	}
+	return nil
+}
+
+type PortCounters struct {
+	PortId               uint32   `protobuf:"varint,1,opt,name=PortId,proto3" json:"PortId,omitempty"`
+	PacketCount          uint64   `protobuf:"varint,2,opt,name=PacketCount,proto3" json:"PacketCount,omitempty"`
+	DroppedPackets       uint64   `protobuf:"varint,3,opt,name=DroppedPackets,proto3" json:"DroppedPackets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortCounters) Reset()         { *m = PortCounters{} }
+func (m *PortCounters) String() string { return proto.CompactTextString(m) }
+func (*PortCounters) ProtoMessage()    {}
+
+func (m *PortCounters) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortCounters.Unmarshal(m, b)
+}
+func (m *PortCounters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortCounters.Marshal(b, m, deterministic)
+}
+func (m *PortCounters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortCounters.Merge(m, src)
+}
+func (m *PortCounters) XXX_Size() int {
+	return xxx_messageInfo_PortCounters.Size(m)
+}
+func (m *PortCounters) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortCounters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortCounters proto.InternalMessageInfo
+
+func (m *PortCounters) GetPortId() uint32 {
+	if m != nil {
+		return m.PortId
+	}
+	return 0
+}
+
+func (m *PortCounters) GetPacketCount() uint64 {
+	if m != nil {
+		return m.PacketCount
+	}
+	return 0
+}
+
+func (m *PortCounters) GetDroppedPackets() uint64 {
+	if m != nil {
+		return m.DroppedPackets
+	}
+	return 0
+}
+
+type StatsSnapshot struct {
+	PktInCount           uint64          `protobuf:"varint,1,opt,name=PktInCount,proto3" json:"PktInCount,omitempty"`
+	DroppedEvents        uint64          `protobuf:"varint,2,opt,name=DroppedEvents,proto3" json:"DroppedEvents,omitempty"`
+	Nnis                 []*PortCounters `protobuf:"bytes,3,rep,name=Nnis,proto3" json:"Nnis,omitempty"`
+	Pons                 []*PortCounters `protobuf:"bytes,4,rep,name=Pons,proto3" json:"Pons,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *StatsSnapshot) Reset()         { *m = StatsSnapshot{} }
+func (m *StatsSnapshot) String() string { return proto.CompactTextString(m) }
+func (*StatsSnapshot) ProtoMessage()    {}
+
+func (m *StatsSnapshot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatsSnapshot.Unmarshal(m, b)
+}
+func (m *StatsSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatsSnapshot.Marshal(b, m, deterministic)
+}
+func (m *StatsSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatsSnapshot.Merge(m, src)
+}
+func (m *StatsSnapshot) XXX_Size() int {
+	return xxx_messageInfo_StatsSnapshot.Size(m)
+}
+func (m *StatsSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatsSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatsSnapshot proto.InternalMessageInfo
+
+func (m *StatsSnapshot) GetPktInCount() uint64 {
+	if m != nil {
+		return m.PktInCount
+	}
+	return 0
+}
+
+func (m *StatsSnapshot) GetDroppedEvents() uint64 {
+	if m != nil {
+		return m.DroppedEvents
+	}
+	return 0
+}
+
+func (m *StatsSnapshot) GetNnis() []*PortCounters {
+	if m != nil {
+		return m.Nnis
+	}
+	return nil
+}
+
+func (m *StatsSnapshot) GetPons() []*PortCounters {
+	if m != nil {
+		return m.Pons
+	}
+	return nil
+}
+
+type BulkOnuAlarmRequest struct {
+	AlarmType            string   `protobuf:"bytes,1,opt,name=AlarmType,proto3" json:"AlarmType,omitempty"`
+	Status               string   `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`
+	SerialNumberPrefix   string   `protobuf:"bytes,3,opt,name=SerialNumberPrefix,proto3" json:"SerialNumberPrefix,omitempty"`
+	PonPortId            int32    `protobuf:"varint,4,opt,name=PonPortId,proto3" json:"PonPortId,omitempty"`
+	InternalState        string   `protobuf:"bytes,5,opt,name=InternalState,proto3" json:"InternalState,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BulkOnuAlarmRequest) Reset()         { *m = BulkOnuAlarmRequest{} }
+func (m *BulkOnuAlarmRequest) String() string { return proto.CompactTextString(m) }
+func (*BulkOnuAlarmRequest) ProtoMessage()    {}
+
+func (m *BulkOnuAlarmRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkOnuAlarmRequest.Unmarshal(m, b)
+}
+func (m *BulkOnuAlarmRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkOnuAlarmRequest.Marshal(b, m, deterministic)
+}
+func (m *BulkOnuAlarmRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkOnuAlarmRequest.Merge(m, src)
+}
+func (m *BulkOnuAlarmRequest) XXX_Size() int {
+	return xxx_messageInfo_BulkOnuAlarmRequest.Size(m)
+}
+func (m *BulkOnuAlarmRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkOnuAlarmRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkOnuAlarmRequest proto.InternalMessageInfo
+
+func (m *BulkOnuAlarmRequest) GetAlarmType() string {
+	if m != nil {
+		return m.AlarmType
+	}
+	return ""
+}
+
+func (m *BulkOnuAlarmRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *BulkOnuAlarmRequest) GetSerialNumberPrefix() string {
+	if m != nil {
+		return m.SerialNumberPrefix
+	}
+	return ""
+}
+
+func (m *BulkOnuAlarmRequest) GetPonPortId() int32 {
+	if m != nil {
+		return m.PonPortId
+	}
+	return 0
+}
+
+func (m *BulkOnuAlarmRequest) GetInternalState() string {
+	if m != nil {
+		return m.InternalState
+	}
+	return ""
+}
+
+type MeAlarmRequest struct {
+	SerialNumber         string   `protobuf:"bytes,1,opt,name=SerialNumber,proto3" json:"SerialNumber,omitempty"`
+	MeClassId            uint32   `protobuf:"varint,2,opt,name=MeClassId,proto3" json:"MeClassId,omitempty"`
+	EntityInstance       uint32   `protobuf:"varint,3,opt,name=EntityInstance,proto3" json:"EntityInstance,omitempty"`
+	AlarmBit             uint32   `protobuf:"varint,4,opt,name=AlarmBit,proto3" json:"AlarmBit,omitempty"`
+	Status               string   `protobuf:"bytes,5,opt,name=Status,proto3" json:"Status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MeAlarmRequest) Reset()         { *m = MeAlarmRequest{} }
+func (m *MeAlarmRequest) String() string { return proto.CompactTextString(m) }
+func (*MeAlarmRequest) ProtoMessage()    {}
+
+func (m *MeAlarmRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MeAlarmRequest.Unmarshal(m, b)
+}
+func (m *MeAlarmRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MeAlarmRequest.Marshal(b, m, deterministic)
+}
+func (m *MeAlarmRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MeAlarmRequest.Merge(m, src)
+}
+func (m *MeAlarmRequest) XXX_Size() int {
+	return xxx_messageInfo_MeAlarmRequest.Size(m)
+}
+func (m *MeAlarmRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MeAlarmRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MeAlarmRequest proto.InternalMessageInfo
+
+func (m *MeAlarmRequest) GetSerialNumber() string {
+	if m != nil {
+		return m.SerialNumber
+	}
+	return ""
+}
+
+func (m *MeAlarmRequest) GetMeClassId() uint32 {
+	if m != nil {
+		return m.MeClassId
+	}
+	return 0
+}
+
+func (m *MeAlarmRequest) GetEntityInstance() uint32 {
+	if m != nil {
+		return m.EntityInstance
+	}
+	return 0
+}
+
+func (m *MeAlarmRequest) GetAlarmBit() uint32 {
+	if m != nil {
+		return m.AlarmBit
+	}
+	return 0
+}
+
+func (m *MeAlarmRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type OmciFaultRequest struct {
+	SerialNumber         string   `protobuf:"bytes,1,opt,name=SerialNumber,proto3" json:"SerialNumber,omitempty"`
+	OmciMsgType          string   `protobuf:"bytes,2,opt,name=OmciMsgType,proto3" json:"OmciMsgType,omitempty"`
+	Drop                 bool     `protobuf:"varint,3,opt,name=Drop,proto3" json:"Drop,omitempty"`
+	DelayMs              uint32   `protobuf:"varint,4,opt,name=DelayMs,proto3" json:"DelayMs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OmciFaultRequest) Reset()         { *m = OmciFaultRequest{} }
+func (m *OmciFaultRequest) String() string { return proto.CompactTextString(m) }
+func (*OmciFaultRequest) ProtoMessage()    {}
+
+func (m *OmciFaultRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OmciFaultRequest.Unmarshal(m, b)
+}
+func (m *OmciFaultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OmciFaultRequest.Marshal(b, m, deterministic)
+}
+func (m *OmciFaultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OmciFaultRequest.Merge(m, src)
+}
+func (m *OmciFaultRequest) XXX_Size() int {
+	return xxx_messageInfo_OmciFaultRequest.Size(m)
+}
+func (m *OmciFaultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OmciFaultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OmciFaultRequest proto.InternalMessageInfo
+
+func (m *OmciFaultRequest) GetSerialNumber() string {
+	if m != nil {
+		return m.SerialNumber
+	}
+	return ""
+}
+
+func (m *OmciFaultRequest) GetOmciMsgType() string {
+	if m != nil {
+		return m.OmciMsgType
+	}
+	return ""
+}
+
+func (m *OmciFaultRequest) GetDrop() bool {
+	if m != nil {
+		return m.Drop
+	}
+	return false
+}
+
+func (m *OmciFaultRequest) GetDelayMs() uint32 {
+	if m != nil {
+		return m.DelayMs
+	}
+	return 0
+}
+
+type OnuLatencyRequest struct {
+	SerialNumber         string   `protobuf:"bytes,1,opt,name=SerialNumber,proto3" json:"SerialNumber,omitempty"`
+	LatencyMs            uint32   `protobuf:"varint,2,opt,name=LatencyMs,proto3" json:"LatencyMs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OnuLatencyRequest) Reset()         { *m = OnuLatencyRequest{} }
+func (m *OnuLatencyRequest) String() string { return proto.CompactTextString(m) }
+func (*OnuLatencyRequest) ProtoMessage()    {}
+
+func (m *OnuLatencyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OnuLatencyRequest.Unmarshal(m, b)
+}
+func (m *OnuLatencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OnuLatencyRequest.Marshal(b, m, deterministic)
+}
+func (m *OnuLatencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnuLatencyRequest.Merge(m, src)
+}
+func (m *OnuLatencyRequest) XXX_Size() int {
+	return xxx_messageInfo_OnuLatencyRequest.Size(m)
+}
+func (m *OnuLatencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnuLatencyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnuLatencyRequest proto.InternalMessageInfo
+
+func (m *OnuLatencyRequest) GetSerialNumber() string {
+	if m != nil {
+		return m.SerialNumber
+	}
+	return ""
+}
+
+func (m *OnuLatencyRequest) GetLatencyMs() uint32 {
+	if m != nil {
+		return m.LatencyMs
+	}
+	return 0
+}
+
+type OnuDistanceRequest struct {
+	SerialNumber         string   `protobuf:"bytes,1,opt,name=SerialNumber,proto3" json:"SerialNumber,omitempty"`
+	DistanceKm           uint32   `protobuf:"varint,2,opt,name=DistanceKm,proto3" json:"DistanceKm,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OnuDistanceRequest) Reset()         { *m = OnuDistanceRequest{} }
+func (m *OnuDistanceRequest) String() string { return proto.CompactTextString(m) }
+func (*OnuDistanceRequest) ProtoMessage()    {}
+
+func (m *OnuDistanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OnuDistanceRequest.Unmarshal(m, b)
+}
+func (m *OnuDistanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OnuDistanceRequest.Marshal(b, m, deterministic)
+}
+func (m *OnuDistanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnuDistanceRequest.Merge(m, src)
+}
+func (m *OnuDistanceRequest) XXX_Size() int {
+	return xxx_messageInfo_OnuDistanceRequest.Size(m)
+}
+func (m *OnuDistanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnuDistanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnuDistanceRequest proto.InternalMessageInfo
+
+func (m *OnuDistanceRequest) GetSerialNumber() string {
+	if m != nil {
+		return m.SerialNumber
+	}
+	return ""
+}
+
+func (m *OnuDistanceRequest) GetDistanceKm() uint32 {
+	if m != nil {
+		return m.DistanceKm
+	}
+	return 0
+}
+
+type BossOnuSla struct {
+	OnuId                uint32   `protobuf:"varint,1,opt,name=OnuId,proto3" json:"OnuId,omitempty"`
+	Tcont                int32    `protobuf:"varint,2,opt,name=Tcont,proto3" json:"Tcont,omitempty"`
+	Type                 int32    `protobuf:"varint,3,opt,name=Type,proto3" json:"Type,omitempty"`
+	Si                   int32    `protobuf:"varint,4,opt,name=Si,proto3" json:"Si,omitempty"`
+	Abmin                int32    `protobuf:"varint,5,opt,name=Abmin,proto3" json:"Abmin,omitempty"`
+	Absur                int32    `protobuf:"varint,6,opt,name=Absur,proto3" json:"Absur,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BossOnuSla) Reset()         { *m = BossOnuSla{} }
+func (m *BossOnuSla) String() string { return proto.CompactTextString(m) }
+func (*BossOnuSla) ProtoMessage()    {}
+
+func (m *BossOnuSla) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BossOnuSla.Unmarshal(m, b)
+}
+func (m *BossOnuSla) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BossOnuSla.Marshal(b, m, deterministic)
+}
+func (m *BossOnuSla) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BossOnuSla.Merge(m, src)
+}
+func (m *BossOnuSla) XXX_Size() int {
+	return xxx_messageInfo_BossOnuSla.Size(m)
+}
+func (m *BossOnuSla) XXX_DiscardUnknown() {
+	xxx_messageInfo_BossOnuSla.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BossOnuSla proto.InternalMessageInfo
+
+func (m *BossOnuSla) GetOnuId() uint32 {
+	if m != nil {
+		return m.OnuId
+	}
+	return 0
+}
+
+func (m *BossOnuSla) GetTcont() int32 {
+	if m != nil {
+		return m.Tcont
+	}
+	return 0
+}
+
+func (m *BossOnuSla) GetType() int32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *BossOnuSla) GetSi() int32 {
+	if m != nil {
+		return m.Si
+	}
+	return 0
+}
+
+func (m *BossOnuSla) GetAbmin() int32 {
+	if m != nil {
+		return m.Abmin
+	}
+	return 0
+}
+
+func (m *BossOnuSla) GetAbsur() int32 {
+	if m != nil {
+		return m.Absur
+	}
+	return 0
+}
+
+type BossConfigSnapshot struct {
+	Mtu                  int32         `protobuf:"varint,1,opt,name=Mtu,proto3" json:"Mtu,omitempty"`
+	VlanMode             int32         `protobuf:"varint,2,opt,name=VlanMode,proto3" json:"VlanMode,omitempty"`
+	AgingMode            int32         `protobuf:"varint,3,opt,name=AgingMode,proto3" json:"AgingMode,omitempty"`
+	AgingTime            int32         `protobuf:"varint,4,opt,name=AgingTime,proto3" json:"AgingTime,omitempty"`
+	DelimiterLength      int32         `protobuf:"varint,5,opt,name=DelimiterLength,proto3" json:"DelimiterLength,omitempty"`
+	Delimiter            string        `protobuf:"bytes,6,opt,name=Delimiter,proto3" json:"Delimiter,omitempty"`
+	PreambleLength       int32         `protobuf:"varint,7,opt,name=PreambleLength,proto3" json:"PreambleLength,omitempty"`
+	Preamble             string        `protobuf:"bytes,8,opt,name=Preamble,proto3" json:"Preamble,omitempty"`
+	PreambleRepeat       int32         `protobuf:"varint,9,opt,name=PreambleRepeat,proto3" json:"PreambleRepeat,omitempty"`
+	BurstVersion         string        `protobuf:"bytes,10,opt,name=BurstVersion,proto3" json:"BurstVersion,omitempty"`
+	BurstVersionIndex    int32         `protobuf:"varint,11,opt,name=BurstVersionIndex,proto3" json:"BurstVersionIndex,omitempty"`
+	Pontag               int64         `protobuf:"varint,12,opt,name=Pontag,proto3" json:"Pontag,omitempty"`
+	ErrorPermit          int32         `protobuf:"varint,13,opt,name=ErrorPermit,proto3" json:"ErrorPermit,omitempty"`
+	FecDecMode           int32         `protobuf:"varint,14,opt,name=FecDecMode,proto3" json:"FecDecMode,omitempty"`
+	Slas                 []*BossOnuSla `protobuf:"bytes,15,rep,name=Slas,proto3" json:"Slas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *BossConfigSnapshot) Reset()         { *m = BossConfigSnapshot{} }
+func (m *BossConfigSnapshot) String() string { return proto.CompactTextString(m) }
+func (*BossConfigSnapshot) ProtoMessage()    {}
+
+func (m *BossConfigSnapshot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BossConfigSnapshot.Unmarshal(m, b)
+}
+func (m *BossConfigSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BossConfigSnapshot.Marshal(b, m, deterministic)
+}
+func (m *BossConfigSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BossConfigSnapshot.Merge(m, src)
+}
+func (m *BossConfigSnapshot) XXX_Size() int {
+	return xxx_messageInfo_BossConfigSnapshot.Size(m)
+}
+func (m *BossConfigSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_BossConfigSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BossConfigSnapshot proto.InternalMessageInfo
+
+func (m *BossConfigSnapshot) GetMtu() int32 {
+	if m != nil {
+		return m.Mtu
+	}
+	return 0
+}
+
+func (m *BossConfigSnapshot) GetVlanMode() int32 {
+	if m != nil {
+		return m.VlanMode
+	}
+	return 0
+}
+
+func (m *BossConfigSnapshot) GetAgingMode() int32 {
+	if m != nil {
+		return m.AgingMode
+	}
+	return 0
+}
+
+func (m *BossConfigSnapshot) GetAgingTime() int32 {
+	if m != nil {
+		return m.AgingTime
+	}
+	return 0
+}
+
+func (m *BossConfigSnapshot) GetDelimiterLength() int32 {
+	if m != nil {
+		return m.DelimiterLength
+	}
+	return 0
+}
+
+func (m *BossConfigSnapshot) GetDelimiter() string {
+	if m != nil {
+		return m.Delimiter
+	}
+	return ""
+}
+
+func (m *BossConfigSnapshot) GetPreambleLength() int32 {
+	if m != nil {
+		return m.PreambleLength
+	}
+	return 0
+}
+
+func (m *BossConfigSnapshot) GetPreamble() string {
+	if m != nil {
+		return m.Preamble
+	}
+	return ""
+}
+
+func (m *BossConfigSnapshot) GetPreambleRepeat() int32 {
+	if m != nil {
+		return m.PreambleRepeat
+	}
+	return 0
+}
+
+func (m *BossConfigSnapshot) GetBurstVersion() string {
+	if m != nil {
+		return m.BurstVersion
+	}
+	return ""
+}
+
+func (m *BossConfigSnapshot) GetBurstVersionIndex() int32 {
+	if m != nil {
+		return m.BurstVersionIndex
+	}
+	return 0
+}
+
+func (m *BossConfigSnapshot) GetPontag() int64 {
+	if m != nil {
+		return m.Pontag
+	}
+	return 0
+}
+
+func (m *BossConfigSnapshot) GetErrorPermit() int32 {
+	if m != nil {
+		return m.ErrorPermit
+	}
+	return 0
+}
+
+func (m *BossConfigSnapshot) GetFecDecMode() int32 {
+	if m != nil {
+		return m.FecDecMode
+	}
+	return 0
+}
+
+func (m *BossConfigSnapshot) GetSlas() []*BossOnuSla {
+	if m != nil {
+		return m.Slas
+	}
+	return nil
+}
+
+type OltRebootRequest struct {
+	Mode                 string   `protobuf:"bytes,1,opt,name=Mode,proto3" json:"Mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OltRebootRequest) Reset()         { *m = OltRebootRequest{} }
+func (m *OltRebootRequest) String() string { return proto.CompactTextString(m) }
+func (*OltRebootRequest) ProtoMessage()    {}
+
+func (m *OltRebootRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OltRebootRequest.Unmarshal(m, b)
+}
+func (m *OltRebootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OltRebootRequest.Marshal(b, m, deterministic)
+}
+func (m *OltRebootRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OltRebootRequest.Merge(m, src)
+}
+func (m *OltRebootRequest) XXX_Size() int {
+	return xxx_messageInfo_OltRebootRequest.Size(m)
+}
+func (m *OltRebootRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OltRebootRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OltRebootRequest proto.InternalMessageInfo
+
+func (m *OltRebootRequest) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+type OnuMeSupportRequest struct {
+	SerialNumber         string   `protobuf:"bytes,1,opt,name=SerialNumber,proto3" json:"SerialNumber,omitempty"`
+	MeClassId            uint32   `protobuf:"varint,2,opt,name=MeClassId,proto3" json:"MeClassId,omitempty"`
+	Supported            bool     `protobuf:"varint,3,opt,name=Supported,proto3" json:"Supported,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OnuMeSupportRequest) Reset()         { *m = OnuMeSupportRequest{} }
+func (m *OnuMeSupportRequest) String() string { return proto.CompactTextString(m) }
+func (*OnuMeSupportRequest) ProtoMessage()    {}
+
+func (m *OnuMeSupportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OnuMeSupportRequest.Unmarshal(m, b)
+}
+func (m *OnuMeSupportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OnuMeSupportRequest.Marshal(b, m, deterministic)
+}
+func (m *OnuMeSupportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnuMeSupportRequest.Merge(m, src)
+}
+func (m *OnuMeSupportRequest) XXX_Size() int {
+	return xxx_messageInfo_OnuMeSupportRequest.Size(m)
+}
+func (m *OnuMeSupportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnuMeSupportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnuMeSupportRequest proto.InternalMessageInfo
+
+func (m *OnuMeSupportRequest) GetSerialNumber() string {
+	if m != nil {
+		return m.SerialNumber
+	}
+	return ""
+}
+
+func (m *OnuMeSupportRequest) GetMeClassId() uint32 {
+	if m != nil {
+		return m.MeClassId
+	}
+	return 0
+}
+
+func (m *OnuMeSupportRequest) GetSupported() bool {
+	if m != nil {
+		return m.Supported
+	}
+	return false
+}
+
+type OnuAal5PmCountersRequest struct {
+	SerialNumber               string   `protobuf:"bytes,1,opt,name=SerialNumber,proto3" json:"SerialNumber,omitempty"`
+	SumOfInvalidCsFieldErrors  uint32   `protobuf:"varint,2,opt,name=SumOfInvalidCsFieldErrors,proto3" json:"SumOfInvalidCsFieldErrors,omitempty"`
+	CrcViolations              uint32   `protobuf:"varint,3,opt,name=CrcViolations,proto3" json:"CrcViolations,omitempty"`
+	ReassemblyTimerExpirations uint32   `protobuf:"varint,4,opt,name=ReassemblyTimerExpirations,proto3" json:"ReassemblyTimerExpirations,omitempty"`
+	BufferOverflows            uint32   `protobuf:"varint,5,opt,name=BufferOverflows,proto3" json:"BufferOverflows,omitempty"`
+	EncapProtocolErrors        uint32   `protobuf:"varint,6,opt,name=EncapProtocolErrors,proto3" json:"EncapProtocolErrors,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
+}
+
+func (m *OnuAal5PmCountersRequest) Reset()         { *m = OnuAal5PmCountersRequest{} }
+func (m *OnuAal5PmCountersRequest) String() string { return proto.CompactTextString(m) }
+func (*OnuAal5PmCountersRequest) ProtoMessage()    {}
+
+func (m *OnuAal5PmCountersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OnuAal5PmCountersRequest.Unmarshal(m, b)
+}
+func (m *OnuAal5PmCountersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OnuAal5PmCountersRequest.Marshal(b, m, deterministic)
+}
+func (m *OnuAal5PmCountersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnuAal5PmCountersRequest.Merge(m, src)
+}
+func (m *OnuAal5PmCountersRequest) XXX_Size() int {
+	return xxx_messageInfo_OnuAal5PmCountersRequest.Size(m)
+}
+func (m *OnuAal5PmCountersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnuAal5PmCountersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnuAal5PmCountersRequest proto.InternalMessageInfo
+
+func (m *OnuAal5PmCountersRequest) GetSerialNumber() string {
+	if m != nil {
+		return m.SerialNumber
+	}
+	return ""
+}
+
+func (m *OnuAal5PmCountersRequest) GetSumOfInvalidCsFieldErrors() uint32 {
+	if m != nil {
+		return m.SumOfInvalidCsFieldErrors
+	}
+	return 0
+}
+
+func (m *OnuAal5PmCountersRequest) GetCrcViolations() uint32 {
+	if m != nil {
+		return m.CrcViolations
+	}
+	return 0
+}
+
+func (m *OnuAal5PmCountersRequest) GetReassemblyTimerExpirations() uint32 {
+	if m != nil {
+		return m.ReassemblyTimerExpirations
+	}
+	return 0
+}
+
+func (m *OnuAal5PmCountersRequest) GetBufferOverflows() uint32 {
+	if m != nil {
+		return m.BufferOverflows
+	}
+	return 0
+}
+
+func (m *OnuAal5PmCountersRequest) GetEncapProtocolErrors() uint32 {
+	if m != nil {
+		return m.EncapProtocolErrors
+	}
+	return 0
+}
+
+type OnuOmciQueue struct {
+	Depth                int32    `protobuf:"varint,1,opt,name=Depth,proto3" json:"Depth,omitempty"`
+	Capacity             int32    `protobuf:"varint,2,opt,name=Capacity,proto3" json:"Capacity,omitempty"`
+	Drops                uint64   `protobuf:"varint,3,opt,name=Drops,proto3" json:"Drops,omitempty"`
+	MessageTypes         []string `protobuf:"bytes,4,rep,name=MessageTypes,proto3" json:"MessageTypes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OnuOmciQueue) Reset()         { *m = OnuOmciQueue{} }
+func (m *OnuOmciQueue) String() string { return proto.CompactTextString(m) }
+func (*OnuOmciQueue) ProtoMessage()    {}
+
+func (m *OnuOmciQueue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OnuOmciQueue.Unmarshal(m, b)
+}
+func (m *OnuOmciQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OnuOmciQueue.Marshal(b, m, deterministic)
+}
+func (m *OnuOmciQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnuOmciQueue.Merge(m, src)
+}
+func (m *OnuOmciQueue) XXX_Size() int {
+	return xxx_messageInfo_OnuOmciQueue.Size(m)
+}
+func (m *OnuOmciQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnuOmciQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnuOmciQueue proto.InternalMessageInfo
+
+func (m *OnuOmciQueue) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *OnuOmciQueue) GetCapacity() int32 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *OnuOmciQueue) GetDrops() uint64 {
+	if m != nil {
+		return m.Drops
+	}
+	return 0
+}
+
+func (m *OnuOmciQueue) GetMessageTypes() []string {
+	if m != nil {
+		return m.MessageTypes
+	}
+	return nil
+}
+
+type PowerLossRequest struct {
+	SerialNumber         string   `protobuf:"bytes,1,opt,name=SerialNumber,proto3" json:"SerialNumber,omitempty"`
+	DelayMs              uint32   `protobuf:"varint,2,opt,name=DelayMs,proto3" json:"DelayMs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PowerLossRequest) Reset()         { *m = PowerLossRequest{} }
+func (m *PowerLossRequest) String() string { return proto.CompactTextString(m) }
+func (*PowerLossRequest) ProtoMessage()    {}
+
+func (m *PowerLossRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PowerLossRequest.Unmarshal(m, b)
+}
+func (m *PowerLossRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PowerLossRequest.Marshal(b, m, deterministic)
+}
+func (m *PowerLossRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PowerLossRequest.Merge(m, src)
+}
+func (m *PowerLossRequest) XXX_Size() int {
+	return xxx_messageInfo_PowerLossRequest.Size(m)
+}
+func (m *PowerLossRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PowerLossRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PowerLossRequest proto.InternalMessageInfo
+
+func (m *PowerLossRequest) GetSerialNumber() string {
+	if m != nil {
+		return m.SerialNumber
+	}
+	return ""
+}
+
+func (m *PowerLossRequest) GetDelayMs() uint32 {
+	if m != nil {
+		return m.DelayMs
+	}
+	return 0
+}
+
+type OltSnapshot struct {
+	Snapshot             []byte   `protobuf:"bytes,1,opt,name=Snapshot,proto3" json:"Snapshot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OltSnapshot) Reset()         { *m = OltSnapshot{} }
+func (m *OltSnapshot) String() string { return proto.CompactTextString(m) }
+func (*OltSnapshot) ProtoMessage()    {}
+
+func (m *OltSnapshot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OltSnapshot.Unmarshal(m, b)
+}
+func (m *OltSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OltSnapshot.Marshal(b, m, deterministic)
+}
+func (m *OltSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OltSnapshot.Merge(m, src)
+}
+func (m *OltSnapshot) XXX_Size() int {
+	return xxx_messageInfo_OltSnapshot.Size(m)
+}
+func (m *OltSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_OltSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OltSnapshot proto.InternalMessageInfo
+
+func (m *OltSnapshot) GetSnapshot() []byte {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
+}
+
+type BulkActivationRequest struct {
+	SerialNumbers        []string `protobuf:"bytes,1,rep,name=SerialNumbers,proto3" json:"SerialNumbers,omitempty"`
+	AllOnPonPort         bool     `protobuf:"varint,2,opt,name=AllOnPonPort,proto3" json:"AllOnPonPort,omitempty"`
+	PonPortId            uint32   `protobuf:"varint,3,opt,name=PonPortId,proto3" json:"PonPortId,omitempty"`
+	PacingMs             uint32   `protobuf:"varint,4,opt,name=PacingMs,proto3" json:"PacingMs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BulkActivationRequest) Reset()         { *m = BulkActivationRequest{} }
+func (m *BulkActivationRequest) String() string { return proto.CompactTextString(m) }
+func (*BulkActivationRequest) ProtoMessage()    {}
+
+func (m *BulkActivationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkActivationRequest.Unmarshal(m, b)
+}
+func (m *BulkActivationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkActivationRequest.Marshal(b, m, deterministic)
+}
+func (m *BulkActivationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkActivationRequest.Merge(m, src)
+}
+func (m *BulkActivationRequest) XXX_Size() int {
+	return xxx_messageInfo_BulkActivationRequest.Size(m)
+}
+func (m *BulkActivationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkActivationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkActivationRequest proto.InternalMessageInfo
+
+func (m *BulkActivationRequest) GetSerialNumbers() []string {
+	if m != nil {
+		return m.SerialNumbers
+	}
+	return nil
+}
+
+func (m *BulkActivationRequest) GetAllOnPonPort() bool {
+	if m != nil {
+		return m.AllOnPonPort
+	}
+	return false
+}
+
+func (m *BulkActivationRequest) GetPonPortId() uint32 {
+	if m != nil {
+		return m.PonPortId
+	}
+	return 0
+}
+
+func (m *BulkActivationRequest) GetPacingMs() uint32 {
+	if m != nil {
+		return m.PacingMs
+	}
+	return 0
+}
+
+type OnuActivationResult struct {
+	SerialNumber         string   `protobuf:"bytes,1,opt,name=SerialNumber,proto3" json:"SerialNumber,omitempty"`
+	Success              bool     `protobuf:"varint,2,opt,name=Success,proto3" json:"Success,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=Message,proto3" json:"Message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OnuActivationResult) Reset()         { *m = OnuActivationResult{} }
+func (m *OnuActivationResult) String() string { return proto.CompactTextString(m) }
+func (*OnuActivationResult) ProtoMessage()    {}
+
+func (m *OnuActivationResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OnuActivationResult.Unmarshal(m, b)
+}
+func (m *OnuActivationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OnuActivationResult.Marshal(b, m, deterministic)
+}
+func (m *OnuActivationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnuActivationResult.Merge(m, src)
+}
+func (m *OnuActivationResult) XXX_Size() int {
+	return xxx_messageInfo_OnuActivationResult.Size(m)
+}
+func (m *OnuActivationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnuActivationResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnuActivationResult proto.InternalMessageInfo
+
+func (m *OnuActivationResult) GetSerialNumber() string {
+	if m != nil {
+		return m.SerialNumber
+	}
+	return ""
+}
+
+func (m *OnuActivationResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *OnuActivationResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type BulkActivationResponse struct {
+	Results              []*OnuActivationResult `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *BulkActivationResponse) Reset()         { *m = BulkActivationResponse{} }
+func (m *BulkActivationResponse) String() string { return proto.CompactTextString(m) }
+func (*BulkActivationResponse) ProtoMessage()    {}
+
+func (m *BulkActivationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkActivationResponse.Unmarshal(m, b)
+}
+func (m *BulkActivationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkActivationResponse.Marshal(b, m, deterministic)
+}
+func (m *BulkActivationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkActivationResponse.Merge(m, src)
+}
+func (m *BulkActivationResponse) XXX_Size() int {
+	return xxx_messageInfo_BulkActivationResponse.Size(m)
+}
+func (m *BulkActivationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkActivationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkActivationResponse proto.InternalMessageInfo
+
+func (m *BulkActivationResponse) GetResults() []*OnuActivationResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type OnuRebootRequest struct {
+	SerialNumber         string   `protobuf:"bytes,1,opt,name=SerialNumber,proto3" json:"SerialNumber,omitempty"`
+	DelayMs              uint32   `protobuf:"varint,2,opt,name=DelayMs,proto3" json:"DelayMs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OnuRebootRequest) Reset()         { *m = OnuRebootRequest{} }
+func (m *OnuRebootRequest) String() string { return proto.CompactTextString(m) }
+func (*OnuRebootRequest) ProtoMessage()    {}
+
+func (m *OnuRebootRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OnuRebootRequest.Unmarshal(m, b)
+}
+func (m *OnuRebootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OnuRebootRequest.Marshal(b, m, deterministic)
+}
+func (m *OnuRebootRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnuRebootRequest.Merge(m, src)
+}
+func (m *OnuRebootRequest) XXX_Size() int {
+	return xxx_messageInfo_OnuRebootRequest.Size(m)
+}
+func (m *OnuRebootRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnuRebootRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnuRebootRequest proto.InternalMessageInfo
+
+func (m *OnuRebootRequest) GetSerialNumber() string {
+	if m != nil {
+		return m.SerialNumber
+	}
+	return ""
+}
+
+func (m *OnuRebootRequest) GetDelayMs() uint32 {
+	if m != nil {
+		return m.DelayMs
+	}
+	return 0
+}
+
+type Event struct {
+	EventType            string   `protobuf:"bytes,1,opt,name=EventType,proto3" json:"EventType,omitempty"`
+	OnuSerial            string   `protobuf:"bytes,2,opt,name=OnuSerial,proto3" json:"OnuSerial,omitempty"`
+	OltID                int32    `protobuf:"varint,3,opt,name=OltID,proto3" json:"OltID,omitempty"`
+	IntfID               int32    `protobuf:"varint,4,opt,name=IntfID,proto3" json:"IntfID,omitempty"`
+	OnuID                int32    `protobuf:"varint,5,opt,name=OnuID,proto3" json:"OnuID,omitempty"`
+	EpochTime            int64    `protobuf:"varint,6,opt,name=EpochTime,proto3" json:"EpochTime,omitempty"`
+	Timestamp            string   `protobuf:"bytes,7,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetEventType() string {
+	if m != nil {
+		return m.EventType
+	}
+	return ""
+}
+
+func (m *Event) GetOnuSerial() string {
+	if m != nil {
+		return m.OnuSerial
+	}
+	return ""
+}
+
+func (m *Event) GetOltID() int32 {
+	if m != nil {
+		return m.OltID
+	}
+	return 0
+}
+
+func (m *Event) GetIntfID() int32 {
+	if m != nil {
+		return m.IntfID
+	}
+	return 0
+}
+
+func (m *Event) GetOnuID() int32 {
+	if m != nil {
+		return m.OnuID
+	}
+	return 0
+}
+
+func (m *Event) GetEpochTime() int64 {
+	if m != nil {
+		return m.EpochTime
+	}
+	return 0
+}
+
+func (m *Event) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("bbsim.UniType", UniType_name, UniType_value)
+	proto.RegisterEnum("bbsim.SubActionTypes", SubActionTypes_name, SubActionTypes_value)
+	proto.RegisterEnum("bbsim.OltAllocatedResourceType_Type", OltAllocatedResourceType_Type_name, OltAllocatedResourceType_Type_value)
+	proto.RegisterEnum("bbsim.AlarmType_Types", AlarmType_Types_name, AlarmType_Types_value)
+	proto.RegisterType((*PONPort)(nil), "bbsim.PONPort")
+	proto.RegisterType((*PonAllocatedResources)(nil), "bbsim.PonAllocatedResources")
+	proto.RegisterType((*NNIPort)(nil), "bbsim.NNIPort")
+	proto.RegisterType((*Olt)(nil), "bbsim.Olt")
+	proto.RegisterType((*OltAllocatedResourceType)(nil), "bbsim.OltAllocatedResourceType")
+	proto.RegisterType((*OltAllocatedResource)(nil), "bbsim.OltAllocatedResource")
+	proto.RegisterType((*OltAllocatedResources)(nil), "bbsim.OltAllocatedResources")
+	proto.RegisterType((*PonResourceUsage)(nil), "bbsim.PonResourceUsage")
+	proto.RegisterType((*PonResourceUtilization)(nil), "bbsim.PonResourceUtilization")
+	proto.RegisterType((*ONU)(nil), "bbsim.ONU")
+	proto.RegisterType((*UNI)(nil), "bbsim.UNI")
+	proto.RegisterType((*Service)(nil), "bbsim.Service")
+	proto.RegisterType((*ONUTrafficSchedulers)(nil), "bbsim.ONUTrafficSchedulers")
+	proto.RegisterType((*ONUs)(nil), "bbsim.ONUs")
+	proto.RegisterType((*Services)(nil), "bbsim.Services")
+	proto.RegisterType((*UNIs)(nil), "bbsim.UNIs")
+	proto.RegisterType((*ONURequest)(nil), "bbsim.ONURequest")
+	proto.RegisterType((*PONRequest)(nil), "bbsim.PONRequest")
+	proto.RegisterType((*UNIRequest)(nil), "bbsim.UNIRequest")
+	proto.RegisterType((*AlarmType)(nil), "bbsim.AlarmType")
+	proto.RegisterType((*AlarmParameter)(nil), "bbsim.AlarmParameter")
+	proto.RegisterType((*ONUAlarmRequest)(nil), "bbsim.ONUAlarmRequest")
+	proto.RegisterType((*OLTAlarmRequest)(nil), "bbsim.OLTAlarmRequest")
+	proto.RegisterType((*VersionNumber)(nil), "bbsim.VersionNumber")
+	proto.RegisterType((*LogLevel)(nil), "bbsim.LogLevel")
+	proto.RegisterType((*Response)(nil), "bbsim.Response")
+	proto.RegisterType((*IgmpRequest)(nil), "bbsim.IgmpRequest")
+	proto.RegisterType((*Flows)(nil), "bbsim.Flows")
+	proto.RegisterType((*Timeout)(nil), "bbsim.Timeout")
+	proto.RegisterType((*NniDhcpTrapVidRequest)(nil), "bbsim.NniDhcpTrapVidRequest")
+	proto.RegisterType((*NNIFlapRequest)(nil), "bbsim.NNIFlapRequest")
+	proto.RegisterType((*AdvanceTodRequest)(nil), "bbsim.AdvanceTodRequest")
+	proto.RegisterType((*ClearFlowsRequest)(nil), "bbsim.ClearFlowsRequest")
+	proto.RegisterType((*PonTechnologyRequest)(nil), "bbsim.PonTechnologyRequest")
+	proto.RegisterType((*OnuServiceRequest)(nil), "bbsim.OnuServiceRequest")
+	proto.RegisterType((*BipErrorRequest)(nil), "bbsim.BipErrorRequest")
+	proto.RegisterType((*SignatureRequest)(nil), "bbsim.SignatureRequest")
+	proto.RegisterType((*Empty)(nil), "bbsim.Empty")
+	proto.RegisterType((*Event)(nil), "bbsim.Event")
+	proto.RegisterType((*BulkOnuAlarmRequest)(nil), "bbsim.BulkOnuAlarmRequest")
+	proto.RegisterType((*MeAlarmRequest)(nil), "bbsim.MeAlarmRequest")
+	proto.RegisterType((*OmciFaultRequest)(nil), "bbsim.OmciFaultRequest")
+	proto.RegisterType((*OnuIdRequest)(nil), "bbsim.OnuIdRequest")
+	proto.RegisterType((*PonOnuStatus)(nil), "bbsim.PonOnuStatus")
+	proto.RegisterType((*PonOnuSummary)(nil), "bbsim.PonOnuSummary")
+	proto.RegisterType((*PonOnuSummaries)(nil), "bbsim.PonOnuSummaries")
+	proto.RegisterType((*PortCounters)(nil), "bbsim.PortCounters")
+	proto.RegisterType((*StatsSnapshot)(nil), "bbsim.StatsSnapshot")
+	proto.RegisterType((*OnuLatencyRequest)(nil), "bbsim.OnuLatencyRequest")
+	proto.RegisterType((*OnuDistanceRequest)(nil), "bbsim.OnuDistanceRequest")
+	proto.RegisterType((*BossOnuSla)(nil), "bbsim.BossOnuSla")
+	proto.RegisterType((*OltRebootRequest)(nil), "bbsim.OltRebootRequest")
+	proto.RegisterType((*BossConfigSnapshot)(nil), "bbsim.BossConfigSnapshot")
+	proto.RegisterType((*OnuMeSupportRequest)(nil), "bbsim.OnuMeSupportRequest")
+	proto.RegisterType((*OnuAal5PmCountersRequest)(nil), "bbsim.OnuAal5PmCountersRequest")
+	proto.RegisterType((*OnuOmciQueue)(nil), "bbsim.OnuOmciQueue")
+	proto.RegisterType((*PowerLossRequest)(nil), "bbsim.PowerLossRequest")
+	proto.RegisterType((*OnuRebootRequest)(nil), "bbsim.OnuRebootRequest")
+	proto.RegisterType((*OltSnapshot)(nil), "bbsim.OltSnapshot")
+	proto.RegisterType((*BulkActivationRequest)(nil), "bbsim.BulkActivationRequest")
+	proto.RegisterType((*OnuActivationResult)(nil), "bbsim.OnuActivationResult")
+	proto.RegisterType((*BulkActivationResponse)(nil), "bbsim.BulkActivationResponse")
+}
+
+func init() { proto.RegisterFile("api/bbsim/bbsim.proto", fileDescriptor_ef7750073d18011b) }
+
+var fileDescriptor_ef7750073d18011b = []byte{
+	// 4084 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3a, 0xcb, 0x72, 0x1b, 0x49,
+	0x72, 0x04, 0x01, 0x10, 0x44, 0x82, 0x20, 0x80, 0xe2, 0x43, 0x10, 0xa5, 0x19, 0x71, 0x7a, 0x35,
+	0x1b, 0x9a, 0xf1, 0xae, 0x34, 0x23, 0x8d, 0x66, 0xa5, 0x99, 0x9d, 0xdd, 0x05, 0x01, 0x90, 0xea,
+	0x25, 0xd1, 0x8d, 0xed, 0x06, 0xa8, 0x18, 0x3f, 0x82, 0xd1, 0x6c, 0x14, 0xc1, 0xf6, 0x34, 0xba,
+	0x31, 0xdd, 0xd5, 0xd4, 0xd0, 0x07, 0x3f, 0x0e, 0x3e, 0xfa, 0xe8, 0xf0, 0xdd, 0x1f, 0xe0, 0xaf,
+	0xf0, 0x07, 0x38, 0x7c, 0x75, 0x84, 0xef, 0x3e, 0xf9, 0x13, 0xec, 0xa8, 0x57, 0x3f, 0xd0, 0x20,
+	0x45, 0xed, 0x65, 0x2f, 0x0c, 0x54, 0x56, 0x66, 0x56, 0x56, 0x66, 0x56, 0xbe, 0x9a, 0xb0, 0x63,
+	0xcd, 0x9d, 0x67, 0xe7, 0xe7, 0xa1, 0x33, 0xe3, 0x7f, 0x9f, 0xce, 0x03, 0x9f, 0xf8, 0xa8, 0xcc,
+	0x16, 0x7b, 0x0f, 0xae, 0x7c, 0x97, 0x5c, 0x5a, 0x67, 0x0c, 0x18, 0x3e, 0xf3, 0xe7, 0xd8, 0xf3,
+	0x5d, 0xc2, 0x71, 0xf6, 0xf6, 0xb3, 0x9b, 0x04, 0xdb, 0x97, 0xf4, 0xf7, 0x85, 0xe3, 0x62, 0x8e,
+	0xa1, 0xfc, 0xdf, 0x2a, 0x54, 0x86, 0xba, 0x36, 0xf4, 0x03, 0x82, 0x36, 0x61, 0x55, 0xed, 0xb5,
+	0x0b, 0xfb, 0x85, 0x27, 0x65, 0x63, 0x55, 0xed, 0xa1, 0x87, 0x50, 0xd5, 0xe7, 0x38, 0x30, 0x89,
+	0x45, 0x70, 0x7b, 0x75, 0xbf, 0xf0, 0xa4, 0x6a, 0x24, 0x00, 0xf4, 0x18, 0xea, 0xaa, 0x47, 0x70,
+	0xe0, 0x59, 0x2e, 0xc7, 0x28, 0x32, 0x8c, 0x2c, 0x10, 0xed, 0x43, 0x6d, 0x68, 0xd9, 0x3f, 0x60,
+	0xd2, 0xf5, 0x23, 0x8f, 0xb4, 0x4b, 0xfb, 0x85, 0x27, 0x25, 0x23, 0x0d, 0x42, 0x87, 0xd0, 0xe8,
+	0xb8, 0xae, 0x6f, 0x5b, 0x04, 0x4f, 0x74, 0x2f, 0x52, 0x27, 0x61, 0xbb, 0xbc, 0x5f, 0x7c, 0x52,
+	0x7b, 0xfe, 0xf0, 0x29, 0xbf, 0xee, 0xd0, 0xf7, 0x62, 0x04, 0x03, 0x87, 0x7e, 0x14, 0xd8, 0x38,
+	0x34, 0x16, 0x89, 0xd0, 0xef, 0xa1, 0x15, 0x83, 0x8e, 0xf0, 0x8c, 0xde, 0x28, 0x6c, 0xaf, 0xdd,
+	0x81, 0x53, 0x9e, 0x2c, 0xc3, 0x8b, 0xfd, 0xa0, 0x52, 0x55, 0x3e, 0x88, 0x97, 0x24, 0x43, 0x1f,
+	0x03, 0x8c, 0xb0, 0x7d, 0xe9, 0xf9, 0xae, 0x3f, 0xbd, 0x6e, 0xaf, 0x33, 0x25, 0xa5, 0x20, 0xca,
+	0x31, 0xec, 0x2c, 0xe5, 0x85, 0x14, 0xd8, 0x30, 0x71, 0xe0, 0x58, 0xae, 0x16, 0xcd, 0xce, 0x71,
+	0xc0, 0x0c, 0x53, 0x35, 0x32, 0x30, 0x66, 0xb2, 0x09, 0xb3, 0x0d, 0x35, 0xd9, 0x44, 0xf9, 0x15,
+	0x54, 0x34, 0x4d, 0xfd, 0x70, 0x6b, 0x2a, 0xff, 0xb4, 0x0a, 0x45, 0xdd, 0xcd, 0x53, 0x2d, 0x0a,
+	0xb1, 0xba, 0x44, 0x88, 0x0c, 0xe7, 0xe2, 0x7b, 0xfd, 0xa4, 0xb4, 0xcc, 0x4f, 0xe8, 0xb9, 0xc3,
+	0x76, 0x85, 0x6d, 0xad, 0xaa, 0x43, 0xf4, 0x39, 0xac, 0x8b, 0x8b, 0x48, 0x77, 0xd8, 0x14, 0x8a,
+	0x17, 0x60, 0x23, 0xde, 0xa7, 0xb8, 0xc2, 0x85, 0xa5, 0xc1, 0x25, 0xae, 0x00, 0x1b, 0xf1, 0x3e,
+	0xfa, 0x39, 0x6c, 0x6a, 0x9e, 0xd3, 0xbb, 0xb4, 0xe7, 0xa3, 0xc0, 0x9a, 0x9f, 0x3a, 0x13, 0x66,
+	0x91, 0xb2, 0xb1, 0x00, 0x55, 0xfe, 0xb1, 0x00, 0x6d, 0xdd, 0x25, 0x39, 0xb3, 0x8c, 0xae, 0xe7,
+	0x18, 0xbd, 0x82, 0x12, 0xb9, 0x9e, 0x63, 0xa6, 0xa6, 0xcd, 0xe7, 0x8f, 0xc5, 0x61, 0x37, 0xa1,
+	0x3f, 0xa5, 0x7f, 0x0c, 0x46, 0xa1, 0x3c, 0x83, 0x12, 0xe3, 0x50, 0x83, 0xca, 0x58, 0x3b, 0xd6,
+	0xf4, 0xb7, 0x5a, 0x73, 0x05, 0x6d, 0xc0, 0x7a, 0xe7, 0xe4, 0x44, 0xef, 0x9e, 0xa9, 0xbd, 0x66,
+	0x81, 0xae, 0x8e, 0xfa, 0x83, 0xb3, 0xa1, 0x6e, 0x8c, 0x9a, 0xab, 0xca, 0xbf, 0x15, 0x60, 0x7b,
+	0x19, 0x63, 0x84, 0x38, 0x27, 0xe1, 0x15, 0x9c, 0xeb, 0x43, 0xa8, 0x0e, 0x7d, 0x8f, 0x5e, 0x54,
+	0x38, 0x45, 0xdd, 0x48, 0x00, 0x68, 0x1b, 0xca, 0xec, 0xa9, 0x30, 0x13, 0xd5, 0x0d, 0xbe, 0x40,
+	0xbb, 0xb0, 0x46, 0xf7, 0x35, 0x9f, 0xd9, 0xa5, 0x6e, 0x88, 0x15, 0x75, 0x5b, 0x79, 0x96, 0x3a,
+	0x69, 0x97, 0x99, 0x92, 0x52, 0x10, 0x4a, 0x77, 0xe8, 0xfa, 0xef, 0xd4, 0x49, 0x7b, 0x8d, 0xbd,
+	0x69, 0xb1, 0x52, 0x0c, 0xd8, 0x59, 0x26, 0x6f, 0x88, 0x5e, 0x43, 0x35, 0x90, 0x8b, 0x76, 0x81,
+	0x99, 0xe9, 0xc1, 0x2d, 0x9a, 0x33, 0x12, 0x6c, 0xe5, 0x7f, 0x4b, 0x50, 0xd4, 0xb5, 0xf1, 0x9f,
+	0xcc, 0x39, 0x53, 0x7a, 0xed, 0x09, 0x55, 0x24, 0x00, 0xb4, 0x0f, 0xd5, 0x37, 0xef, 0x3a, 0x93,
+	0x49, 0x80, 0xc3, 0x90, 0xbf, 0xef, 0x83, 0xd5, 0x76, 0xc1, 0x48, 0x80, 0x68, 0x2f, 0xd6, 0x71,
+	0x95, 0x12, 0xb3, 0x6d, 0xa9, 0xe7, 0xa7, 0xb0, 0x1e, 0xe2, 0xe0, 0xca, 0xa1, 0x5a, 0x81, 0x8c,
+	0xf3, 0x9a, 0x1c, 0xcc, 0xb0, 0x63, 0x1c, 0xd4, 0x83, 0x8f, 0xd4, 0x99, 0x35, 0xc5, 0xa6, 0x7f,
+	0x41, 0xde, 0x59, 0x01, 0xee, 0xff, 0x34, 0xc7, 0x36, 0xc1, 0x13, 0x13, 0xdb, 0xc4, 0xf1, 0xbd,
+	0xb0, 0x5d, 0x63, 0xf2, 0xdd, 0x8e, 0x94, 0xe3, 0x62, 0x60, 0x1b, 0x3b, 0x57, 0x29, 0x2e, 0x1b,
+	0x4b, 0xb8, 0x2c, 0x22, 0xa1, 0x2f, 0x60, 0xab, 0x63, 0x13, 0xe7, 0x0a, 0x33, 0xb4, 0xbe, 0x47,
+	0x1c, 0x72, 0xad, 0x4e, 0xda, 0x75, 0x46, 0xbb, 0x6c, 0x0b, 0x7d, 0x0d, 0xbb, 0x5d, 0x7f, 0x36,
+	0x73, 0x08, 0xc1, 0x93, 0x2c, 0xd1, 0x26, 0x23, 0xba, 0x61, 0x17, 0x7d, 0x0c, 0xa5, 0xc8, 0x73,
+	0xc2, 0x76, 0x83, 0x69, 0x08, 0x84, 0x86, 0xc6, 0x9a, 0x6a, 0x30, 0x38, 0x7a, 0x02, 0x8d, 0x13,
+	0x2b, 0x24, 0xcc, 0x5c, 0xdd, 0x4b, 0xcb, 0x9b, 0xe2, 0x76, 0x93, 0x59, 0x72, 0x11, 0x4c, 0x2d,
+	0x3e, 0x9e, 0x13, 0x67, 0x86, 0x4d, 0x6c, 0xfb, 0xde, 0x24, 0x6c, 0xb7, 0x98, 0xfb, 0x66, 0x81,
+	0xca, 0x7f, 0x15, 0xa0, 0x38, 0xd6, 0xd4, 0x9c, 0xc7, 0x89, 0x37, 0xd4, 0x13, 0x21, 0x97, 0x2f,
+	0x04, 0xd4, 0xf4, 0x84, 0x7f, 0xf1, 0x05, 0x7d, 0xa1, 0x03, 0xac, 0xf6, 0xc4, 0xbb, 0x62, 0xbf,
+	0xb3, 0xde, 0x58, 0x5e, 0xf4, 0xc6, 0xe4, 0x2d, 0xae, 0x31, 0xf6, 0xd2, 0x47, 0x3e, 0x4f, 0xf9,
+	0x48, 0x65, 0x99, 0x8f, 0xa4, 0xfc, 0x43, 0x11, 0x71, 0x61, 0x9d, 0xc5, 0x26, 0x89, 0x37, 0xf6,
+	0x1c, 0x1e, 0x85, 0xe8, 0x5f, 0xe5, 0x7f, 0xca, 0x50, 0x11, 0x94, 0x54, 0x4a, 0xcd, 0x9a, 0xc5,
+	0x71, 0x84, 0xfe, 0xa6, 0x52, 0x26, 0x1e, 0x2d, 0x52, 0x45, 0xe2, 0xcd, 0x37, 0xde, 0xd6, 0x1c,
+	0x59, 0x53, 0x76, 0xdb, 0xb2, 0xc1, 0x7e, 0x53, 0x58, 0x97, 0xc2, 0xf8, 0x93, 0x61, 0xbf, 0x69,
+	0x5c, 0xd1, 0x30, 0x9e, 0x84, 0x7d, 0x6b, 0xee, 0xbb, 0xec, 0x9e, 0xeb, 0x46, 0x0a, 0x42, 0xcf,
+	0x66, 0x2b, 0x1a, 0x8c, 0x59, 0x3e, 0x58, 0x37, 0x12, 0x40, 0xbc, 0xab, 0x4e, 0x67, 0x73, 0x76,
+	0x45, 0xb9, 0x4b, 0x01, 0xa8, 0x0d, 0x15, 0x91, 0xc2, 0xf9, 0x43, 0x33, 0xe4, 0x92, 0x9e, 0xca,
+	0xd8, 0x73, 0xc5, 0x03, 0x4f, 0xc2, 0x09, 0x84, 0xf2, 0xa5, 0xfc, 0xf9, 0x76, 0x8d, 0xdf, 0x38,
+	0x06, 0xe4, 0xa3, 0xc4, 0xc6, 0x0d, 0x51, 0x42, 0x3d, 0x1a, 0x0c, 0x39, 0x46, 0x9d, 0xf3, 0x88,
+	0x01, 0x54, 0x6b, 0x63, 0xcf, 0x11, 0x8e, 0x5e, 0x37, 0xf8, 0x82, 0x96, 0x47, 0xd4, 0x34, 0xd6,
+	0x74, 0x60, 0x11, 0xfb, 0xb2, 0xdd, 0x60, 0x52, 0xa7, 0x41, 0x34, 0x61, 0x8d, 0x43, 0xaa, 0xb9,
+	0x61, 0xe0, 0xf8, 0x81, 0x43, 0xae, 0x99, 0x63, 0xd7, 0x8d, 0x05, 0x28, 0xc7, 0x33, 0xd3, 0x78,
+	0x2d, 0x89, 0x67, 0x2e, 0xe0, 0xf5, 0xb2, 0xfc, 0x10, 0xc7, 0xeb, 0xe5, 0xf8, 0xf5, 0xb2, 0xfc,
+	0xb6, 0x24, 0x5e, 0x86, 0x9f, 0xb4, 0xe7, 0x70, 0x38, 0xf4, 0xfb, 0xed, 0xed, 0x94, 0x3d, 0x19,
+	0x84, 0xc6, 0x88, 0xae, 0xef, 0x5d, 0x38, 0xd3, 0x28, 0xc0, 0x03, 0xcb, 0x96, 0x5e, 0xb5, 0xc3,
+	0x10, 0x97, 0x6d, 0xa1, 0x5f, 0x40, 0xab, 0xef, 0x59, 0xe7, 0x2e, 0x85, 0x9d, 0x60, 0x2b, 0xf0,
+	0x1c, 0x6f, 0xda, 0xde, 0x65, 0xf8, 0xf9, 0x0d, 0x7a, 0x7e, 0x27, 0x0c, 0x9d, 0xa9, 0x87, 0x27,
+	0xea, 0xbc, 0x7d, 0x8f, 0x5b, 0x36, 0x81, 0x28, 0x16, 0x6c, 0xeb, 0xda, 0x78, 0x14, 0x58, 0x17,
+	0x17, 0x8e, 0x6d, 0xda, 0x97, 0x78, 0x12, 0xb9, 0x38, 0x08, 0x91, 0x0a, 0x0d, 0x42, 0x81, 0x09,
+	0x88, 0x3d, 0x81, 0xda, 0xf3, 0x47, 0x4f, 0x33, 0x65, 0x72, 0x8e, 0xd2, 0x58, 0xa4, 0x53, 0x9e,
+	0x40, 0x49, 0xd7, 0xc6, 0x21, 0xda, 0x87, 0xb2, 0x43, 0xf0, 0x4c, 0x66, 0x37, 0x19, 0xa5, 0x74,
+	0x6d, 0x6c, 0xf0, 0x0d, 0xe5, 0x0b, 0x58, 0x37, 0xe5, 0x43, 0x7d, 0x9c, 0xc5, 0x5e, 0x7c, 0xd1,
+	0x82, 0xe2, 0x09, 0x94, 0xc6, 0x9a, 0x7a, 0x23, 0x6f, 0x1a, 0x01, 0x63, 0xde, 0x40, 0x4f, 0xc2,
+	0x3f, 0x46, 0x38, 0x24, 0x77, 0x29, 0x1e, 0x95, 0xcf, 0x01, 0x86, 0xba, 0x26, 0x29, 0x32, 0xc5,
+	0x43, 0x61, 0xa1, 0x78, 0x50, 0x4e, 0x00, 0xe8, 0x59, 0x02, 0xf7, 0x09, 0x34, 0xe8, 0xab, 0xcf,
+	0x1f, 0xb0, 0x08, 0x96, 0x6e, 0xdf, 0x13, 0x61, 0x84, 0x2f, 0x94, 0xff, 0x2e, 0x42, 0xb5, 0xe3,
+	0x5a, 0xc1, 0x8c, 0x85, 0xa3, 0xff, 0x28, 0x42, 0x99, 0xfe, 0x08, 0x51, 0x05, 0x8a, 0x27, 0xba,
+	0xd9, 0x5c, 0x41, 0x9b, 0x00, 0xbd, 0xef, 0x55, 0xed, 0xe8, 0xec, 0xa8, 0x63, 0x0e, 0x9b, 0x05,
+	0x54, 0x87, 0xaa, 0xae, 0x8d, 0xcf, 0x3a, 0x27, 0x1d, 0x63, 0xd0, 0x5c, 0x45, 0xf7, 0x60, 0x8b,
+	0x2e, 0xcd, 0x51, 0xc7, 0x18, 0x8d, 0x87, 0x67, 0x87, 0x1d, 0xf5, 0x64, 0x6c, 0xf4, 0x9b, 0x45,
+	0xb4, 0x0b, 0x88, 0x6d, 0xa8, 0x47, 0x5a, 0xe7, 0xe4, 0xac, 0xd7, 0x3f, 0x32, 0x3a, 0xbd, 0x7e,
+	0xb3, 0x24, 0x09, 0x7a, 0x86, 0x7a, 0x38, 0x3a, 0xd3, 0x0f, 0xcf, 0xde, 0xaa, 0x5a, 0x4f, 0x7f,
+	0xdb, 0x2c, 0xa3, 0x87, 0xd0, 0xa6, 0x1b, 0x27, 0xba, 0x69, 0x52, 0xb8, 0x3e, 0xe8, 0xaa, 0x67,
+	0xdd, 0x37, 0x1d, 0x4d, 0xeb, 0x9f, 0x34, 0xd7, 0xe2, 0x73, 0x18, 0x3b, 0x33, 0x3e, 0xa7, 0x82,
+	0x3e, 0x83, 0x4f, 0xe9, 0xc6, 0xc8, 0xe8, 0x68, 0xe6, 0x40, 0x35, 0x4d, 0x55, 0xd7, 0xce, 0x54,
+	0x6d, 0xd4, 0x37, 0x0e, 0xfb, 0x46, 0x5f, 0xeb, 0xf6, 0xcf, 0xde, 0x76, 0x0c, 0x4d, 0xd5, 0x8e,
+	0x9a, 0xeb, 0x68, 0x0f, 0x76, 0x99, 0xe8, 0xdd, 0x91, 0x7a, 0xda, 0x19, 0x51, 0x44, 0xc9, 0xa6,
+	0x8a, 0xda, 0xcc, 0x39, 0xcf, 0x86, 0x86, 0xde, 0xed, 0x9b, 0x26, 0xbd, 0x6f, 0xdf, 0x30, 0x74,
+	0xa3, 0x09, 0x68, 0x1f, 0x1e, 0xa6, 0xe5, 0x3a, 0xee, 0x7f, 0x7f, 0x66, 0x7e, 0xaf, 0x75, 0x63,
+	0xda, 0x1a, 0xda, 0x81, 0x16, 0xc5, 0x50, 0x47, 0xe3, 0xb3, 0xa1, 0xae, 0x51, 0x5d, 0x8c, 0xcc,
+	0xe6, 0x06, 0x6a, 0x41, 0x3d, 0xd6, 0x14, 0x25, 0x6f, 0xd6, 0x17, 0x41, 0x07, 0xcd, 0x4d, 0x79,
+	0x31, 0x09, 0x1a, 0x76, 0xcf, 0xe8, 0x2d, 0x9a, 0x0d, 0xa9, 0x8f, 0xcc, 0x46, 0x57, 0x48, 0xd5,
+	0x44, 0x08, 0x36, 0xd3, 0xbb, 0x87, 0x6a, 0xb3, 0x85, 0xb6, 0xa0, 0x91, 0x86, 0x75, 0x06, 0x6a,
+	0x13, 0x29, 0xaf, 0x60, 0x93, 0xd9, 0x77, 0x68, 0x05, 0xd6, 0x0c, 0x13, 0x1c, 0xa0, 0x26, 0x14,
+	0x8f, 0xf1, 0xb5, 0x70, 0x13, 0xfa, 0x93, 0xba, 0xc6, 0xa9, 0xe5, 0x46, 0xb2, 0x19, 0xe1, 0x0b,
+	0xe5, 0x5f, 0x0b, 0x8c, 0x1f, 0xa3, 0x4e, 0xb9, 0x66, 0xec, 0x2d, 0x82, 0x43, 0x02, 0xb8, 0x53,
+	0x15, 0xb8, 0x0b, 0x6b, 0x34, 0x0c, 0x47, 0xa1, 0x48, 0x5a, 0x62, 0x85, 0x5e, 0x02, 0xc4, 0x22,
+	0x86, 0xed, 0x12, 0x7b, 0x5b, 0x3b, 0xe2, 0x6d, 0x65, 0x2f, 0x60, 0xa4, 0x10, 0x95, 0x1f, 0xa1,
+	0xa1, 0x9f, 0x8c, 0x32, 0x32, 0xee, 0x43, 0x8d, 0xa5, 0x83, 0x0b, 0xcb, 0xc6, 0xa2, 0x64, 0xa8,
+	0x1b, 0x69, 0x50, 0x9c, 0x45, 0xe8, 0x92, 0xdd, 0x64, 0x35, 0x95, 0x45, 0x24, 0xf0, 0x26, 0x49,
+	0x69, 0x43, 0x52, 0x3f, 0xc5, 0x41, 0xe8, 0xf8, 0x9e, 0xb8, 0x53, 0x1b, 0x2a, 0x57, 0x1c, 0x20,
+	0x74, 0x22, 0x97, 0x54, 0x5f, 0xe7, 0x91, 0xe3, 0x4e, 0x46, 0xce, 0x2c, 0x6e, 0xf5, 0x62, 0x00,
+	0x8d, 0x98, 0x36, 0xab, 0xb2, 0xde, 0x58, 0xe1, 0xa5, 0x38, 0x25, 0x05, 0xa1, 0xd4, 0x53, 0x87,
+	0x08, 0x21, 0x78, 0x3d, 0x9c, 0x00, 0x94, 0x57, 0xb0, 0x7e, 0xe2, 0x4f, 0x4f, 0xf0, 0x15, 0x76,
+	0xa9, 0x05, 0x5d, 0xfa, 0x43, 0x9c, 0xcf, 0x17, 0xf4, 0x06, 0xb6, 0xe5, 0xba, 0xc2, 0x12, 0xeb,
+	0x86, 0x58, 0x29, 0x7d, 0x58, 0x37, 0x70, 0x38, 0xf7, 0xbd, 0x10, 0xa3, 0x47, 0x50, 0x0b, 0x19,
+	0xbf, 0x33, 0xdb, 0x9f, 0x60, 0x51, 0x60, 0x01, 0x07, 0x75, 0xfd, 0x09, 0xa6, 0x97, 0x9b, 0xe1,
+	0x30, 0xb4, 0xa6, 0xf2, 0x02, 0x72, 0xa9, 0xfc, 0x4b, 0x01, 0x6a, 0x34, 0xdb, 0x4b, 0xc5, 0x7f,
+	0x06, 0x6b, 0xba, 0x17, 0x19, 0xf8, 0x47, 0x11, 0xbf, 0x5b, 0xa9, 0xb0, 0xcb, 0x51, 0x0c, 0x81,
+	0x80, 0x5e, 0xc3, 0x86, 0x19, 0x9d, 0x77, 0x58, 0xf5, 0x7a, 0x6a, 0xb9, 0x8c, 0xf3, 0x66, 0x6c,
+	0xef, 0x78, 0x8b, 0xc5, 0x22, 0x23, 0x83, 0x4a, 0x9d, 0xec, 0x28, 0xf0, 0xa3, 0xb9, 0xcc, 0x5f,
+	0x5c, 0x6d, 0x19, 0x98, 0x72, 0x0c, 0x65, 0xda, 0x04, 0x85, 0xe8, 0x23, 0x80, 0x0b, 0xd7, 0x7f,
+	0x77, 0x66, 0xb3, 0x99, 0x87, 0x88, 0xa5, 0x14, 0xc2, 0x27, 0x1e, 0x3f, 0x83, 0x32, 0x5d, 0xd0,
+	0xd2, 0x8a, 0xfa, 0x5b, 0xfd, 0xa9, 0x1c, 0xda, 0x50, 0x6a, 0x83, 0xef, 0x29, 0x8f, 0xa0, 0x42,
+	0xad, 0xe5, 0x47, 0x84, 0xaa, 0x79, 0x82, 0x5d, 0xeb, 0x5a, 0x70, 0xe2, 0x0b, 0xa5, 0x02, 0xe5,
+	0xfe, 0x6c, 0x4e, 0xae, 0x95, 0xbf, 0x83, 0xe6, 0xd0, 0xf7, 0x64, 0xdf, 0x34, 0xa6, 0x4a, 0x42,
+	0xad, 0x5c, 0x30, 0x47, 0xdb, 0xb0, 0x21, 0x67, 0x12, 0xe3, 0x10, 0x8b, 0xfe, 0x10, 0xed, 0x40,
+	0x5d, 0x42, 0x47, 0x3e, 0xb1, 0x5c, 0xde, 0x1c, 0x52, 0x64, 0x39, 0x0c, 0x61, 0xc8, 0x25, 0x89,
+	0x2c, 0xa1, 0x1c, 0x99, 0x16, 0x76, 0x75, 0xe5, 0xb7, 0xb0, 0x9b, 0x16, 0x80, 0x38, 0xae, 0xf3,
+	0x37, 0x16, 0x55, 0x1c, 0xfa, 0x14, 0x4a, 0x43, 0xda, 0x4d, 0xf0, 0xa4, 0x75, 0x2f, 0x19, 0x9d,
+	0x64, 0xa4, 0x55, 0xbe, 0x80, 0x0d, 0xd6, 0x8c, 0x4a, 0x93, 0x2e, 0x91, 0xbe, 0x2e, 0x9b, 0x57,
+	0x26, 0xb6, 0xf2, 0x57, 0xb0, 0x31, 0xf4, 0x3d, 0x9a, 0x6c, 0xf8, 0x3b, 0x8e, 0xb7, 0xe3, 0xbb,
+	0xe6, 0x43, 0x02, 0x15, 0x7f, 0xc9, 0xc4, 0x8a, 0x9e, 0x96, 0xd4, 0xe4, 0xcc, 0xdf, 0x15, 0x17,
+	0xea, 0x82, 0x7d, 0x34, 0x9b, 0x59, 0xc1, 0xf5, 0x32, 0x89, 0x5a, 0x50, 0x65, 0x4a, 0xd0, 0xbd,
+	0x28, 0x14, 0xca, 0x44, 0x00, 0xbc, 0xe9, 0x61, 0x30, 0xae, 0xc9, 0x4f, 0xa0, 0xc4, 0x56, 0x3c,
+	0xb6, 0x6c, 0x25, 0x2a, 0x88, 0x85, 0x57, 0x5e, 0x42, 0x23, 0x7d, 0x9a, 0xc3, 0xab, 0xf8, 0x94,
+	0xe2, 0xb6, 0xb3, 0x54, 0x5c, 0x26, 0xe5, 0x98, 0xea, 0x20, 0xe0, 0x53, 0x34, 0x5a, 0xd1, 0x6c,
+	0xf2, 0xee, 0x21, 0x16, 0x70, 0x2b, 0x3b, 0x7a, 0xa3, 0x22, 0x96, 0xd0, 0x2e, 0x6c, 0xf6, 0x02,
+	0x7f, 0x3e, 0xc7, 0x13, 0xbe, 0xc7, 0xc5, 0x2c, 0x29, 0x7f, 0x5f, 0x80, 0x3a, 0x15, 0x27, 0x34,
+	0x3d, 0x6b, 0x1e, 0x5e, 0xfa, 0x84, 0x5e, 0x66, 0xf8, 0x03, 0x51, 0xbd, 0x6e, 0xec, 0xc4, 0x25,
+	0xaa, 0x41, 0x41, 0xdd, 0xbf, 0xc2, 0x1e, 0x09, 0x05, 0xd3, 0x4f, 0xa0, 0xa4, 0xd1, 0xee, 0xac,
+	0xb8, 0x70, 0xc7, 0x94, 0x70, 0x9f, 0x88, 0x0b, 0x95, 0x6e, 0x44, 0x51, 0xfe, 0x16, 0xb6, 0x0e,
+	0x22, 0xf7, 0x07, 0xdd, 0x8b, 0x32, 0x81, 0xb5, 0x95, 0x0b, 0xfe, 0xf4, 0xa6, 0x22, 0x3c, 0x71,
+	0xc3, 0xee, 0x01, 0x4a, 0x9b, 0x7b, 0x18, 0xe0, 0x0b, 0xe7, 0xa7, 0xc4, 0xba, 0x89, 0xe5, 0x58,
+	0x73, 0x92, 0xf7, 0x03, 0xd6, 0x88, 0x29, 0x21, 0x6c, 0x0e, 0x70, 0xe6, 0xe8, 0xed, 0x65, 0x45,
+	0x14, 0xe5, 0x38, 0xc0, 0x5d, 0xd7, 0x0a, 0x43, 0xe9, 0x8e, 0x54, 0xab, 0xa2, 0x55, 0xf5, 0x42,
+	0x62, 0x79, 0x36, 0x16, 0xc6, 0x6f, 0xc2, 0x3a, 0x63, 0x78, 0xe0, 0x10, 0xf1, 0x84, 0x12, 0xd1,
+	0xf9, 0xa1, 0x7f, 0x09, 0x4d, 0x7d, 0x66, 0x3b, 0x87, 0x56, 0xe4, 0x92, 0xdb, 0x8f, 0xdd, 0x82,
+	0x1a, 0xc5, 0x1c, 0x84, 0xd3, 0x24, 0x79, 0xa0, 0x0d, 0x28, 0x51, 0x83, 0xb0, 0xe3, 0xd6, 0x51,
+	0x03, 0x2a, 0x3d, 0x1a, 0x1b, 0x06, 0x3c, 0x6e, 0xd7, 0x95, 0x5f, 0x43, 0x4b, 0xf7, 0xa2, 0x13,
+	0x8b, 0x60, 0xcf, 0xbe, 0x7e, 0xef, 0xad, 0x04, 0xde, 0x40, 0xb8, 0xb3, 0xf2, 0x1b, 0x40, 0xba,
+	0x17, 0xf5, 0x1c, 0x7e, 0xa5, 0xdb, 0xc9, 0x11, 0x80, 0x44, 0x3c, 0x9e, 0x09, 0xfa, 0x7d, 0x68,
+	0xea, 0xf4, 0x56, 0xe7, 0xbe, 0x1f, 0xdf, 0x6d, 0x03, 0x4a, 0x03, 0x19, 0xf1, 0xab, 0xca, 0x39,
+	0xc0, 0x81, 0x1f, 0x86, 0xd4, 0xa9, 0x5d, 0x6b, 0xf1, 0x11, 0xd7, 0xa1, 0x3c, 0xb2, 0x7d, 0xe1,
+	0xb9, 0x65, 0x4a, 0xc9, 0x2e, 0x5e, 0x64, 0x2b, 0x80, 0x55, 0xd3, 0x11, 0xf6, 0xac, 0x43, 0xb9,
+	0x73, 0x3e, 0x73, 0x3c, 0xde, 0x67, 0xf2, 0x65, 0x18, 0x05, 0xbc, 0x89, 0x56, 0xfe, 0x73, 0x15,
+	0x10, 0x3d, 0x84, 0xb7, 0x1a, 0xb1, 0x7b, 0xd7, 0xa0, 0x38, 0x20, 0x11, 0xcf, 0x3c, 0xd4, 0x4e,
+	0xa7, 0xae, 0xe5, 0x31, 0xc9, 0xf8, 0x69, 0xd4, 0xeb, 0xa6, 0x8e, 0x37, 0x65, 0xa0, 0x62, 0x06,
+	0xc4, 0xb2, 0x2a, 0x3f, 0xf9, 0x1e, 0x34, 0x7a, 0xd8, 0x75, 0x66, 0x0e, 0xc1, 0xc1, 0x09, 0xf6,
+	0xa6, 0xe4, 0x52, 0xc8, 0xd0, 0x82, 0x6a, 0xbc, 0xc1, 0xe4, 0xa8, 0x52, 0x1f, 0x19, 0x06, 0xd8,
+	0x9a, 0x9d, 0xbb, 0x58, 0xa0, 0x56, 0xe4, 0xd9, 0x12, 0xce, 0xa7, 0x47, 0x69, 0x4c, 0x03, 0xcf,
+	0xb1, 0x25, 0xba, 0x59, 0xaa, 0xf9, 0x83, 0x28, 0x08, 0x89, 0x28, 0x03, 0x78, 0x1b, 0x8b, 0xee,
+	0x43, 0x2b, 0x0d, 0x55, 0xbd, 0x09, 0xfe, 0x89, 0x0f, 0x81, 0x78, 0x44, 0xf0, 0x88, 0x35, 0x65,
+	0x0d, 0x6b, 0x91, 0xba, 0x50, 0x3f, 0x08, 0xfc, 0x60, 0x88, 0x83, 0x99, 0x43, 0xf8, 0x9c, 0x86,
+	0x5a, 0xee, 0x10, 0xdb, 0x3d, 0x6c, 0xb3, 0xab, 0xb2, 0x31, 0x0c, 0x7a, 0x04, 0x25, 0xd3, 0xb5,
+	0xe4, 0xb8, 0x45, 0x66, 0xd4, 0xc4, 0x54, 0xca, 0x1f, 0x60, 0x4b, 0xf7, 0xa2, 0x01, 0x36, 0xa3,
+	0xf9, 0xdc, 0x0f, 0xc8, 0x07, 0x3f, 0x98, 0x16, 0x54, 0x05, 0x29, 0xe6, 0xf3, 0xc8, 0x75, 0xe5,
+	0xdf, 0x0b, 0xd0, 0xa6, 0x6f, 0xdf, 0x72, 0x5f, 0x0e, 0x67, 0x32, 0x28, 0xdc, 0xce, 0xf8, 0x13,
+	0xb8, 0x6f, 0x46, 0x33, 0xfd, 0x42, 0xf5, 0xae, 0x2c, 0xd7, 0x99, 0x74, 0xc3, 0x43, 0x07, 0xbb,
+	0x13, 0x76, 0xc5, 0x30, 0xc9, 0x6f, 0xdd, 0xc0, 0x3e, 0x75, 0x7c, 0xd7, 0xe2, 0x83, 0x2d, 0xfe,
+	0x30, 0x15, 0xd8, 0x33, 0xb0, 0x15, 0x86, 0x78, 0x76, 0xee, 0x5e, 0x53, 0x83, 0x06, 0xfd, 0x9f,
+	0xe6, 0x4e, 0x20, 0x70, 0xf8, 0x53, 0xbd, 0x07, 0x8d, 0x83, 0xe8, 0xe2, 0x02, 0x07, 0xfa, 0x15,
+	0x0e, 0x78, 0xc2, 0x66, 0xf9, 0x0e, 0x3d, 0x80, 0xad, 0xbe, 0x67, 0x5b, 0xf3, 0x61, 0xe0, 0x13,
+	0xdf, 0xf6, 0x5d, 0x71, 0xe0, 0x1a, 0x73, 0xfa, 0x11, 0xcb, 0x65, 0xf4, 0xa5, 0xfe, 0x21, 0xc2,
+	0x11, 0xa6, 0xde, 0xd8, 0xc3, 0x73, 0x72, 0x99, 0x78, 0x5a, 0xd7, 0x9a, 0x5b, 0x36, 0x6d, 0xa8,
+	0x57, 0xa5, 0xbb, 0xd2, 0x27, 0x2c, 0x02, 0x31, 0xbd, 0xe9, 0x80, 0x57, 0x3a, 0xac, 0x0c, 0x61,
+	0x01, 0xb3, 0xaa, 0xbc, 0xa6, 0x39, 0xfe, 0x1d, 0x0e, 0x4e, 0xfc, 0xf0, 0x3d, 0x3a, 0x49, 0xc5,
+	0x00, 0xfe, 0x0a, 0x1d, 0xd8, 0xa1, 0x61, 0x95, 0x25, 0x26, 0x76, 0x3f, 0x49, 0xbf, 0x03, 0xf5,
+	0x34, 0x3d, 0x4f, 0x36, 0x55, 0x51, 0x27, 0xe8, 0x9e, 0x88, 0x9a, 0xbc, 0x88, 0xcb, 0x86, 0xd1,
+	0x38, 0xb8, 0x0d, 0x2d, 0x9b, 0xbe, 0x11, 0x19, 0x6e, 0x06, 0xcc, 0x2b, 0xd2, 0x27, 0x85, 0x91,
+	0x7b, 0x8b, 0xa0, 0x66, 0x64, 0xdb, 0x72, 0xc4, 0xc4, 0xa2, 0x97, 0xb8, 0x39, 0x0f, 0xdd, 0x4a,
+	0x1f, 0x76, 0x17, 0x25, 0x17, 0xe5, 0xe3, 0x9f, 0x41, 0x85, 0xf3, 0x96, 0x19, 0x72, 0x4f, 0x16,
+	0x7d, 0xf9, 0xe3, 0xa9, 0xee, 0x58, 0xfd, 0x97, 0x0e, 0x43, 0x77, 0xd4, 0xdd, 0x23, 0xa8, 0xe9,
+	0x2e, 0x89, 0x63, 0x46, 0x13, 0xd6, 0xe5, 0x6f, 0x46, 0xb1, 0xa1, 0x3c, 0x86, 0x9d, 0xec, 0x87,
+	0x03, 0x79, 0x40, 0x0d, 0x8a, 0xa7, 0x0e, 0x8f, 0x64, 0x65, 0xe5, 0x5b, 0xd8, 0xd4, 0x34, 0xf5,
+	0xd0, 0xb5, 0xe6, 0xa9, 0xa4, 0xa6, 0x79, 0x4e, 0x26, 0x5d, 0xd3, 0xcc, 0xec, 0xbf, 0xf3, 0x7a,
+	0x11, 0xf7, 0xc2, 0x58, 0x86, 0xc7, 0xd0, 0xea, 0x4c, 0xae, 0x68, 0x60, 0x1d, 0xf9, 0x31, 0x7b,
+	0xaa, 0x3c, 0x31, 0xbf, 0x2c, 0x88, 0x1a, 0xac, 0xd5, 0x75, 0xb1, 0x15, 0xb0, 0x02, 0x54, 0x62,
+	0xdd, 0xbb, 0xa1, 0x4d, 0xcf, 0x5a, 0x93, 0x1f, 0xf3, 0x1d, 0x6c, 0x0f, 0x7d, 0x2f, 0xf9, 0x2e,
+	0x75, 0x4b, 0x2d, 0x86, 0x32, 0x5f, 0xb4, 0x56, 0x45, 0xc5, 0xd4, 0xe2, 0x47, 0xb1, 0xe1, 0xc5,
+	0xfb, 0xce, 0xaf, 0xcb, 0x61, 0xd8, 0xaa, 0xac, 0x54, 0x04, 0x25, 0x1b, 0x45, 0x16, 0x65, 0x6a,
+	0xeb, 0xc6, 0x03, 0x45, 0xba, 0x32, 0xe3, 0x51, 0xa2, 0xf2, 0x15, 0x34, 0x0e, 0x9c, 0x39, 0x7b,
+	0x77, 0xf2, 0xac, 0x4d, 0x58, 0x53, 0x3d, 0x72, 0x91, 0x2e, 0xcf, 0x24, 0x8a, 0x28, 0x53, 0x94,
+	0x4f, 0xa1, 0x69, 0x3a, 0x53, 0xcf, 0x22, 0x51, 0x80, 0x53, 0xd7, 0x8b, 0x61, 0x42, 0x95, 0xff,
+	0x50, 0x80, 0x32, 0x2b, 0x6f, 0xe8, 0x26, 0xfb, 0x91, 0x2a, 0x3d, 0x68, 0xb1, 0x28, 0xaf, 0x24,
+	0x72, 0x30, 0x4d, 0x5b, 0x2e, 0x51, 0x7b, 0x22, 0x4d, 0x48, 0x41, 0x7a, 0x49, 0x76, 0xe2, 0x23,
+	0xe3, 0x38, 0x33, 0xf4, 0xe7, 0xbe, 0x7d, 0xc9, 0xb2, 0xc8, 0x1a, 0x0b, 0xcb, 0xb4, 0x92, 0x74,
+	0x66, 0x38, 0x24, 0xd6, 0x8c, 0x8f, 0x3c, 0xab, 0x9f, 0x3f, 0x84, 0x8a, 0x18, 0xd9, 0xa2, 0x0a,
+	0x14, 0xfb, 0xa3, 0x37, 0xcd, 0x15, 0xb4, 0x0e, 0xa5, 0xa1, 0x3e, 0x32, 0x9b, 0x85, 0xcf, 0xbf,
+	0x84, 0xcd, 0x6c, 0xb3, 0x42, 0xf7, 0x7e, 0xaf, 0xab, 0x5a, 0x73, 0x05, 0x55, 0xa1, 0x7c, 0xd2,
+	0xef, 0x9c, 0xf6, 0x9b, 0x05, 0x04, 0xb0, 0x46, 0x81, 0xa7, 0x2f, 0x9a, 0xab, 0xcf, 0xff, 0xf9,
+	0x63, 0x28, 0x1f, 0x1c, 0x98, 0xce, 0x0c, 0x3d, 0x83, 0x8a, 0x48, 0x15, 0x68, 0x43, 0xbc, 0x1a,
+	0xd6, 0x47, 0xec, 0xc9, 0x2a, 0x33, 0xd3, 0x65, 0x2a, 0x2b, 0xe8, 0x4b, 0x6a, 0x1d, 0x12, 0x37,
+	0x7d, 0x0d, 0x81, 0x26, 0x01, 0x7b, 0x8b, 0x00, 0x65, 0x05, 0x3d, 0x86, 0xb5, 0x23, 0x4c, 0x74,
+	0x97, 0x2c, 0x1c, 0x01, 0xc9, 0x07, 0x1f, 0x65, 0x05, 0xfd, 0x05, 0xb4, 0x39, 0xd6, 0x92, 0xaf,
+	0x45, 0x8f, 0xde, 0xf3, 0x51, 0x6d, 0xef, 0xe1, 0x2d, 0x08, 0xa1, 0xb2, 0x82, 0x7e, 0x09, 0xc0,
+	0x22, 0xa6, 0xef, 0xe5, 0xc5, 0x90, 0x12, 0xcb, 0x78, 0xa2, 0xac, 0xa0, 0xa7, 0x50, 0x33, 0x2f,
+	0x23, 0x32, 0xf1, 0xdf, 0xdd, 0x0d, 0xff, 0x17, 0x50, 0xe5, 0x11, 0xe5, 0x4e, 0xd8, 0xd4, 0x60,
+	0xc4, 0x9f, 0x4f, 0x8d, 0x61, 0x97, 0x3a, 0x3a, 0x0e, 0xde, 0x4f, 0xf2, 0x1c, 0x1a, 0x26, 0xb1,
+	0x02, 0xf2, 0x21, 0x34, 0x5f, 0x43, 0xcb, 0xa0, 0x6e, 0x94, 0xa1, 0x92, 0x83, 0x45, 0xd1, 0x4d,
+	0x2e, 0xa3, 0xfb, 0x8c, 0x9b, 0x4b, 0x1b, 0xa3, 0x7c, 0xf3, 0xbc, 0x97, 0x1a, 0x63, 0x2a, 0x2b,
+	0xe8, 0xe7, 0x50, 0xe1, 0xa8, 0xe1, 0x82, 0x38, 0xb5, 0x04, 0x8d, 0xaa, 0xff, 0x05, 0xd4, 0x8e,
+	0x30, 0x89, 0x87, 0x9d, 0xad, 0xd4, 0xbc, 0x52, 0xf0, 0x6d, 0x64, 0x07, 0x9e, 0x21, 0x33, 0x02,
+	0x50, 0xe6, 0x5e, 0x34, 0xf6, 0x9c, 0x70, 0x99, 0x2c, 0xb5, 0x84, 0x8d, 0x38, 0x24, 0x36, 0xda,
+	0x72, 0xe1, 0x6f, 0x50, 0xac, 0x20, 0xa2, 0x79, 0x2e, 0x7f, 0x93, 0x25, 0x34, 0xaf, 0xa1, 0x95,
+	0x3a, 0x28, 0xd4, 0xbd, 0xa1, 0xae, 0xc5, 0xc7, 0x25, 0x33, 0xd4, 0xe5, 0xc7, 0xc5, 0x7e, 0x78,
+	0x67, 0x11, 0xbf, 0x84, 0x4d, 0x41, 0x73, 0x67, 0x09, 0x5f, 0x89, 0x02, 0xc1, 0xff, 0x60, 0x01,
+	0xbf, 0x82, 0x0d, 0xe1, 0x34, 0xfc, 0x03, 0xcc, 0x2d, 0xa6, 0x4a, 0x51, 0xbd, 0x80, 0x9a, 0xa0,
+	0x62, 0xdf, 0x65, 0xee, 0x46, 0x74, 0x00, 0x3b, 0x26, 0xb3, 0x2f, 0x6b, 0x8a, 0x54, 0x6f, 0xe2,
+	0xd8, 0x7c, 0x4e, 0xb0, 0x9b, 0xa8, 0x25, 0xdd, 0x80, 0xdd, 0xc2, 0x83, 0xbe, 0xfa, 0x1b, 0x78,
+	0x64, 0x07, 0x73, 0xcb, 0x78, 0xfc, 0x12, 0xd6, 0x8f, 0x30, 0xe1, 0xb3, 0x9a, 0x25, 0x16, 0x91,
+	0xca, 0x66, 0x08, 0x4c, 0xb7, 0x0d, 0xfe, 0xf1, 0x50, 0x9d, 0xce, 0xc4, 0x17, 0x21, 0x24, 0x50,
+	0x52, 0x83, 0xa8, 0x65, 0x07, 0x1d, 0xc3, 0x3d, 0xee, 0xd0, 0xf9, 0xef, 0x0f, 0x4b, 0xce, 0x7d,
+	0x90, 0x80, 0x72, 0xf8, 0xf1, 0xd3, 0x63, 0x4f, 0x63, 0xf9, 0xd3, 0x13, 0xaf, 0xe2, 0x25, 0x6d,
+	0x6f, 0x59, 0x41, 0x6c, 0x11, 0x3c, 0x98, 0x84, 0x77, 0x74, 0xba, 0x37, 0x70, 0xff, 0x08, 0x93,
+	0x1b, 0x06, 0x39, 0xd9, 0x03, 0x3f, 0x5a, 0x32, 0xc8, 0x49, 0x21, 0xbf, 0x00, 0xd4, 0x0b, 0x2c,
+	0xc7, 0xeb, 0x78, 0x93, 0x9e, 0x13, 0x5a, 0xe7, 0x2e, 0x7e, 0x7f, 0x90, 0x44, 0x5f, 0x41, 0x53,
+	0xd6, 0x56, 0xb4, 0xe4, 0x62, 0x5a, 0xce, 0x92, 0xa0, 0x24, 0xe2, 0xc7, 0x15, 0xd9, 0xd7, 0xd0,
+	0xa0, 0x6e, 0xe8, 0x07, 0x38, 0x26, 0x5a, 0x82, 0x96, 0x3f, 0xed, 0x00, 0x5a, 0x26, 0x26, 0xd9,
+	0xd2, 0x0d, 0xc9, 0x94, 0xb2, 0xb4, 0xa2, 0xcb, 0xf3, 0xf8, 0x12, 0x2a, 0xb4, 0xa4, 0xd3, 0x34,
+	0x15, 0xed, 0x24, 0xff, 0x9b, 0x92, 0xaa, 0xf2, 0xf2, 0x24, 0xbf, 0x82, 0x7a, 0x97, 0x56, 0x72,
+	0xae, 0x40, 0xfc, 0x00, 0x42, 0x48, 0x8a, 0x40, 0xd4, 0x96, 0x13, 0xea, 0xc5, 0xba, 0x30, 0x4f,
+	0xf8, 0x3b, 0x68, 0x9a, 0xcc, 0xaa, 0x49, 0xc5, 0x86, 0x1e, 0x24, 0xe6, 0xcb, 0xd5, 0x7b, 0x79,
+	0x0e, 0xaf, 0x61, 0x57, 0xf5, 0xfe, 0x1a, 0xdb, 0xe4, 0x0d, 0xb6, 0x02, 0x72, 0x8e, 0x2d, 0x72,
+	0x68, 0x39, 0x6e, 0x14, 0xe0, 0xf7, 0x66, 0x16, 0xf4, 0x2d, 0x34, 0xf8, 0x5b, 0x8d, 0x4b, 0x2c,
+	0x24, 0x67, 0x80, 0x8b, 0x85, 0x58, 0x9e, 0xf8, 0xb7, 0xb0, 0x6d, 0x62, 0x22, 0x6b, 0x38, 0x2e,
+	0x42, 0xfa, 0x9d, 0x2f, 0x14, 0x80, 0x79, 0x06, 0xdf, 0x00, 0xe2, 0x0e, 0x9d, 0x99, 0xac, 0x65,
+	0x7d, 0x6a, 0x77, 0xc9, 0x64, 0x8d, 0x62, 0x7d, 0x0b, 0xcd, 0x05, 0xda, 0xeb, 0x65, 0xe1, 0x74,
+	0xe9, 0x60, 0x0e, 0x7d, 0xcd, 0x88, 0xb3, 0xd3, 0xb4, 0xe5, 0xa5, 0x56, 0x16, 0xe7, 0x1b, 0xa8,
+	0x77, 0x26, 0x93, 0xa4, 0x8c, 0x8e, 0xed, 0x9c, 0xab, 0xac, 0xf3, 0x97, 0xfd, 0x0e, 0x9a, 0x06,
+	0x9e, 0xf9, 0x6c, 0xf4, 0xf8, 0x47, 0x90, 0xff, 0x0e, 0xb6, 0x4d, 0x67, 0x16, 0xb9, 0x16, 0xa1,
+	0x0c, 0xe2, 0x56, 0x13, 0x25, 0x23, 0xdb, 0x6c, 0xf3, 0x99, 0xe7, 0xf0, 0x32, 0x2e, 0x88, 0xb4,
+	0x71, 0x4c, 0xb6, 0xd8, 0x77, 0x2d, 0x23, 0x6b, 0xf0, 0x17, 0xc1, 0x82, 0x13, 0x45, 0xbd, 0x4b,
+	0xb8, 0x42, 0xc7, 0xb0, 0x21, 0xfa, 0x3c, 0xcc, 0xf2, 0xa3, 0x7c, 0xba, 0x4b, 0x3b, 0xdd, 0x38,
+	0x5e, 0xdd, 0xd0, 0x4d, 0xbe, 0x81, 0xfb, 0xd4, 0xd3, 0x52, 0xb3, 0xc7, 0x54, 0x5a, 0xd9, 0x4b,
+	0xd1, 0x2e, 0x8c, 0x26, 0xf3, 0x62, 0x75, 0xe0, 0x1e, 0x4f, 0x70, 0x62, 0x90, 0x98, 0xe2, 0x23,
+	0x5f, 0x7a, 0x76, 0xc4, 0xb8, 0xf4, 0xa5, 0x27, 0x8d, 0x5c, 0x6c, 0xc2, 0x5c, 0x6f, 0x97, 0x27,
+	0xd4, 0xe1, 0xc1, 0x0d, 0xb9, 0xe6, 0xe0, 0x5a, 0x9d, 0xa0, 0xad, 0xc4, 0x24, 0xf1, 0xa0, 0xfd,
+	0xd6, 0x8c, 0x83, 0xbe, 0x85, 0x1d, 0x19, 0xbe, 0xa9, 0x19, 0x27, 0x4e, 0x68, 0xfb, 0x57, 0x38,
+	0xf5, 0x10, 0x6e, 0x33, 0xd0, 0x37, 0xb0, 0xcd, 0xbf, 0xd5, 0xff, 0x11, 0xb4, 0x22, 0x6c, 0xf0,
+	0x29, 0x0a, 0x9b, 0x8c, 0x26, 0x0e, 0xb5, 0x30, 0x2b, 0xcd, 0x13, 0xbf, 0x02, 0xc4, 0x94, 0x95,
+	0x26, 0xbf, 0x53, 0x0a, 0x44, 0x87, 0xcc, 0x0d, 0x04, 0x9d, 0x84, 0x8a, 0x99, 0x68, 0xfa, 0x2d,
+	0x65, 0xc7, 0xa9, 0x79, 0x3e, 0xbf, 0x86, 0x4d, 0xce, 0x47, 0x0e, 0x44, 0xd1, 0xfd, 0x84, 0x78,
+	0x61, 0x9a, 0xba, 0x4c, 0xfe, 0xfa, 0x11, 0x26, 0xc9, 0xc0, 0x72, 0x21, 0x72, 0xdc, 0x4f, 0xcd,
+	0xe2, 0x16, 0x26, 0x9a, 0xdf, 0xd1, 0xea, 0x5f, 0xb4, 0x24, 0x6f, 0x1d, 0x72, 0x39, 0xf0, 0x27,
+	0x49, 0xbc, 0x5d, 0x1c, 0xc4, 0x2e, 0x8b, 0xf3, 0x8d, 0xa3, 0xf8, 0xfa, 0x7c, 0x76, 0xb5, 0x44,
+	0x6b, 0x29, 0x37, 0x4a, 0xf0, 0x7e, 0x23, 0x0d, 0x16, 0x0f, 0x04, 0x51, 0x6a, 0x20, 0xb3, 0x38,
+	0x25, 0xcc, 0x1f, 0xfd, 0x86, 0x85, 0xfa, 0xdc, 0xf0, 0x2f, 0x69, 0x02, 0x6f, 0x18, 0x0b, 0xe6,
+	0x39, 0x3d, 0x83, 0x86, 0x19, 0x9d, 0x87, 0x76, 0xe0, 0x9c, 0x63, 0xfe, 0x89, 0x62, 0x41, 0x7f,
+	0xf1, 0x8a, 0x6e, 0x7e, 0x51, 0x38, 0xf8, 0xf4, 0xcf, 0x7f, 0x36, 0x75, 0xc8, 0x65, 0x74, 0xfe,
+	0xd4, 0xf6, 0x67, 0xec, 0xbf, 0xa7, 0x6d, 0x3f, 0x98, 0x88, 0x7f, 0xb7, 0x8e, 0xff, 0xf1, 0xfa,
+	0x7c, 0x8d, 0xfd, 0xb7, 0xf4, 0x8b, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x93, 0x6c, 0x1a, 0x2c,
+	0x8c, 0x2d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BBSimClient is the client API for BBSim service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BBSimClient interface {
+	// Get BBSim version
+	Version(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*VersionNumber, error)
+	// Set BBSim log level
+	SetLogLevel(ctx context.Context, in *LogLevel, opts ...grpc.CallOption) (*LogLevel, error)
+	// Get current status of OLT
+	GetOlt(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Olt, error)
+	GetOltAllocatedResources(ctx context.Context, in *OltAllocatedResourceType, opts ...grpc.CallOption) (*OltAllocatedResources, error)
+	// Get aggregate alloc-id and gem-port utilization per PON port
+	GetPonResourceUtilization(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PonResourceUtilization, error)
+	// Poweron OLT
+	PoweronOlt(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error)
+	// Shutdown OLT
+	ShutdownOlt(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error)
+	// Disable every ONU and then the OLT for a clean full teardown
+	DrainAndDisableOlt(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error)
+	// Reboot OLT
+	RebootOlt(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error)
+	// Dump the provisioned OLT state (ONU records, flows, BOSS config) as JSON
+	SnapshotOltState(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*OltSnapshot, error)
+	// Restore a dump produced by SnapshotOltState, rebuilding the resource maps
+	RestoreOltState(ctx context.Context, in *OltSnapshot, opts ...grpc.CallOption) (*Response, error)
+	// Change the VLAN on which DHCP packets are trapped at the NNI
+	SetNniDhcpTrapVid(ctx context.Context, in *NniDhcpTrapVidRequest, opts ...grpc.CallOption) (*Response, error)
+	// Flap an NNI: it goes operationally down and back up after DownDurationMs
+	FlapNNI(ctx context.Context, in *NNIFlapRequest, opts ...grpc.CallOption) (*Response, error)
+	// Abort an NNI flap in progress, bringing the NNI back up immediately
+	CancelNNIFlap(ctx context.Context, in *NNIFlapRequest, opts ...grpc.CallOption) (*Response, error)
+	// Test helper: jump the simulated time-of-day forward without waiting
+	AdvanceTod(ctx context.Context, in *AdvanceTodRequest, opts ...grpc.CallOption) (*Response, error)
+	ClearFlows(ctx context.Context, in *ClearFlowsRequest, opts ...grpc.CallOption) (*Response, error)
+	SetPonTechnology(ctx context.Context, in *PonTechnologyRequest, opts ...grpc.CallOption) (*Response, error)
+	// Closes the Openolt gRPC server
+	StopgRPCServer(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error)
+	// Start the Openolt gRPC server
+	StartgRPCServer(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error)
+	// Restart the Openolt gRPC server after the given timeout
+	RestartgRPCServer(ctx context.Context, in *Timeout, opts ...grpc.CallOption) (*Response, error)
+	// Make the OLT fail HeartbeatCheck calls for the given number of seconds
+	InjectHeartbeatFailure(ctx context.Context, in *Timeout, opts ...grpc.CallOption) (*Response, error)
+	// Replace the OLT signature without a reboot to force a signature
+	// mismatch on the next HeartbeatCheck, 0 picks a fresh one
+	SetOltSignature(ctx context.Context, in *SignatureRequest, opts ...grpc.CallOption) (*Response, error)
+	// Inject a fixed BIP error count in the port stats of an interface,
+	// regardless of the latency flag. A count of 0 clears the injection.
+	SetBipErrorInjection(ctx context.Context, in *BipErrorRequest, opts ...grpc.CallOption) (*Response, error)
+	// Get status of an ONU by serial number
+	GetONU(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*ONU, error)
+	// Get status of all ONUs
+	GetONUs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ONUs, error)
+	// Get the ONU count summary for all PON ports
+	GetPonOnuSummaries(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PonOnuSummaries, error)
+	// Get the ONU count summary for a PON port by pon-port-ID
+	GetPonOnuSummary(ctx context.Context, in *PONRequest, opts ...grpc.CallOption) (*PonOnuSummary, error)
+	// Get a lock-consistent snapshot of all the device counters
+	GetStatsSnapshot(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StatsSnapshot, error)
+	// Get all the Services by Uni ID
+	GetServices(ctx context.Context, in *UNIRequest, opts ...grpc.CallOption) (*Services, error)
+	// Add a configured service to an ONU UNI at runtime
+	AddOnuService(ctx context.Context, in *OnuServiceRequest, opts ...grpc.CallOption) (*Response, error)
+	// Remove a service from an ONU UNI at runtime
+	RemoveOnuService(ctx context.Context, in *OnuServiceRequest, opts ...grpc.CallOption) (*Response, error)
+	// Get all the UNIs of an ONU by serial number
+	GetOnuUnis(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*UNIs, error)
+	// Shutdown an ONU by serial number
+	ShutdownONU(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Response, error)
+	// Shutdown all ONUs in OLT
+	ShutdownAllONUs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error)
+	// Shutdown all ONUs under a PON by pon-port-ID
+	ShutdownONUsOnPON(ctx context.Context, in *PONRequest, opts ...grpc.CallOption) (*Response, error)
+	// Simulate an ONU power loss: dying-gasp then down, recoverable via PoweronONU
+	SimulateOnuPowerLoss(ctx context.Context, in *PowerLossRequest, opts ...grpc.CallOption) (*Response, error)
+	// Reboot an ONU: it goes down, waits DelayMs and powers back on by itself
+	RebootONU(ctx context.Context, in *OnuRebootRequest, opts ...grpc.CallOption) (*Response, error)
+	// Abort a pending ONU reboot before the ONU powers back on
+	CancelONUReboot(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Response, error)
+	// Poweron an ONU by serial number
+	PoweronONU(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Response, error)
+	// Poweron all ONUs in OLT
+	PoweronAllONUs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error)
+	// Poweron all ONUs under a PON by pon-port-ID
+	PoweronONUsOnPON(ctx context.Context, in *PONRequest, opts ...grpc.CallOption) (*Response, error)
+	// Activate many ONUs in one call with optional pacing, per-ONU results
+	ActivateONUs(ctx context.Context, in *BulkActivationRequest, opts ...grpc.CallOption) (*BulkActivationResponse, error)
+	// Restart EAPOL for ONU
+	RestartEapol(ctx context.Context, in *UNIRequest, opts ...grpc.CallOption) (*Response, error)
+	// Restart DHCP for ONU
+	RestartDhcp(ctx context.Context, in *UNIRequest, opts ...grpc.CallOption) (*Response, error)
+	// Send ONU alarm indication
+	SetOnuAlarmIndication(ctx context.Context, in *ONUAlarmRequest, opts ...grpc.CallOption) (*Response, error)
+	// Send the same ONU alarm indication on every ONU matching the filters
+	SetBulkOnuAlarmIndication(ctx context.Context, in *BulkOnuAlarmRequest, opts ...grpc.CallOption) (*Response, error)
+	// Raise or clear an OMCI alarm of an ME that declares an alarm map
+	SetOnuMeAlarmIndication(ctx context.Context, in *MeAlarmRequest, opts ...grpc.CallOption) (*Response, error)
+	// Send OLT alarm indication for Interface type NNI or PON
+	SetOltAlarmIndication(ctx context.Context, in *OLTAlarmRequest, opts ...grpc.CallOption) (*Response, error)
+	// Get all flows or ONU specific flows
+	GetFlows(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Flows, error)
+	// Change IGMP state
+	ChangeIgmpState(ctx context.Context, in *IgmpRequest, opts ...grpc.CallOption) (*Response, error)
+	// Get Traffic scheduler information for ONU
+	GetOnuTrafficSchedulers(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*ONUTrafficSchedulers, error)
+	// Get the Traffic schedulers installed on an ONU by intf/onu id,
+	// errors if the ONU is unknown or no schedulers are installed
+	GetOnuTrafficSchedulersById(ctx context.Context, in *OnuIdRequest, opts ...grpc.CallOption) (*ONUTrafficSchedulers, error)
+	//Return all the UNIs for all the ONUs
+	GetUnis(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*UNIs, error)
+	//Invalidate the MDS counter for the ONU
+	InvalidateMds(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Response, error)
+	// Suppress the automatic re-discovery of an ONU after delete/deactivate
+	DisableOnuRediscovery(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Response, error)
+	// Resume the automatic re-discovery of an ONU after delete/deactivate
+	EnableOnuRediscovery(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Response, error)
+	// Inject a fault on a specific OMCI message type for an ONU
+	SetOnuOmciFault(ctx context.Context, in *OmciFaultRequest, opts ...grpc.CallOption) (*Response, error)
+	// Clear every injected OMCI fault on an ONU
+	ClearOnuOmciFaults(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Response, error)
+	// Set the latency applied before every OMCI response of an ONU
+	SetOnuOmciResponseLatency(ctx context.Context, in *OnuLatencyRequest, opts ...grpc.CallOption) (*Response, error)
+	SetOnuDistance(ctx context.Context, in *OnuDistanceRequest, opts ...grpc.CallOption) (*Response, error)
+	GetBossConfig(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BossConfigSnapshot, error)
+	RebootOltWithMode(ctx context.Context, in *OltRebootRequest, opts ...grpc.CallOption) (*Response, error)
+	// Inspect the OMCI messages pending on an ONU channel
+	GetOnuOmciQueue(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*OnuOmciQueue, error)
+	// Mark an OMCI managed entity as supported or unsupported on an ONU
+	SetOnuMeSupport(ctx context.Context, in *OnuMeSupportRequest, opts ...grpc.CallOption) (*Response, error)
+	// Inject AAL5 PM history counters on an ONU
+	SetOnuAal5PmCounters(ctx context.Context, in *OnuAal5PmCountersRequest, opts ...grpc.CallOption) (*Response, error)
+	// Subscribe to the stream of events published by the simulator
+	SubscribeEvents(ctx context.Context, in *Empty, opts ...grpc.CallOption) (BBSim_SubscribeEventsClient, error)
+}
+
+type bBSimClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBBSimClient(cc *grpc.ClientConn) BBSimClient {
+	return &bBSimClient{cc}
+}
+
+func (c *bBSimClient) Version(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*VersionNumber, error) {
+	out := new(VersionNumber)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/Version", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) SetLogLevel(ctx context.Context, in *LogLevel, opts ...grpc.CallOption) (*LogLevel, error) {
+	out := new(LogLevel)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) GetOlt(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Olt, error) {
+	out := new(Olt)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetOlt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) GetOltAllocatedResources(ctx context.Context, in *OltAllocatedResourceType, opts ...grpc.CallOption) (*OltAllocatedResources, error) {
+	out := new(OltAllocatedResources)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetOltAllocatedResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) GetPonResourceUtilization(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PonResourceUtilization, error) {
+	out := new(PonResourceUtilization)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetPonResourceUtilization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) PoweronOlt(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/PoweronOlt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) ShutdownOlt(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/ShutdownOlt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) DrainAndDisableOlt(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/DrainAndDisableOlt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) SnapshotOltState(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*OltSnapshot, error) {
+	out := new(OltSnapshot)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/SnapshotOltState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) RestoreOltState(ctx context.Context, in *OltSnapshot, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/RestoreOltState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) RebootOlt(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/RebootOlt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) SetNniDhcpTrapVid(ctx context.Context, in *NniDhcpTrapVidRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/SetNniDhcpTrapVid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) FlapNNI(ctx context.Context, in *NNIFlapRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/FlapNNI", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) CancelNNIFlap(ctx context.Context, in *NNIFlapRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/CancelNNIFlap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) AdvanceTod(ctx context.Context, in *AdvanceTodRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/AdvanceTod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) ClearFlows(ctx context.Context, in *ClearFlowsRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/ClearFlows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) SetPonTechnology(ctx context.Context, in *PonTechnologyRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/SetPonTechnology", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) StopgRPCServer(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/StopgRPCServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) StartgRPCServer(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/StartgRPCServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) RestartgRPCServer(ctx context.Context, in *Timeout, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/RestartgRPCServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) SetOltSignature(ctx context.Context, in *SignatureRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/SetOltSignature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) SetBipErrorInjection(ctx context.Context, in *BipErrorRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/SetBipErrorInjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) InjectHeartbeatFailure(ctx context.Context, in *Timeout, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/InjectHeartbeatFailure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) GetONU(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*ONU, error) {
+	out := new(ONU)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetONU", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) GetONUs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ONUs, error) {
+	out := new(ONUs)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetONUs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) GetPonOnuSummaries(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PonOnuSummaries, error) {
+	out := new(PonOnuSummaries)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetPonOnuSummaries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) GetPonOnuSummary(ctx context.Context, in *PONRequest, opts ...grpc.CallOption) (*PonOnuSummary, error) {
+	out := new(PonOnuSummary)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetPonOnuSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) GetStatsSnapshot(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StatsSnapshot, error) {
+	out := new(StatsSnapshot)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetStatsSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) GetServices(ctx context.Context, in *UNIRequest, opts ...grpc.CallOption) (*Services, error) {
+	out := new(Services)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetServices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) AddOnuService(ctx context.Context, in *OnuServiceRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/AddOnuService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) RemoveOnuService(ctx context.Context, in *OnuServiceRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/RemoveOnuService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) GetOnuUnis(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*UNIs, error) {
+	out := new(UNIs)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetOnuUnis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) ShutdownONU(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/ShutdownONU", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) ShutdownAllONUs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/ShutdownAllONUs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) ShutdownONUsOnPON(ctx context.Context, in *PONRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/ShutdownONUsOnPON", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) SimulateOnuPowerLoss(ctx context.Context, in *PowerLossRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/SimulateOnuPowerLoss", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) RebootONU(ctx context.Context, in *OnuRebootRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/RebootONU", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) CancelONUReboot(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/CancelONUReboot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) PoweronONU(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/PoweronONU", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) PoweronAllONUs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/PoweronAllONUs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) PoweronONUsOnPON(ctx context.Context, in *PONRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/PoweronONUsOnPON", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) ActivateONUs(ctx context.Context, in *BulkActivationRequest, opts ...grpc.CallOption) (*BulkActivationResponse, error) {
+	out := new(BulkActivationResponse)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/ActivateONUs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) RestartEapol(ctx context.Context, in *UNIRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/RestartEapol", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) RestartDhcp(ctx context.Context, in *UNIRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/RestartDhcp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) SetOnuAlarmIndication(ctx context.Context, in *ONUAlarmRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/SetOnuAlarmIndication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) SetBulkOnuAlarmIndication(ctx context.Context, in *BulkOnuAlarmRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/SetBulkOnuAlarmIndication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) SetOnuMeAlarmIndication(ctx context.Context, in *MeAlarmRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/SetOnuMeAlarmIndication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) SetOltAlarmIndication(ctx context.Context, in *OLTAlarmRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/SetOltAlarmIndication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) GetFlows(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Flows, error) {
+	out := new(Flows)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetFlows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) ChangeIgmpState(ctx context.Context, in *IgmpRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/ChangeIgmpState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) GetOnuTrafficSchedulers(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*ONUTrafficSchedulers, error) {
+	out := new(ONUTrafficSchedulers)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetOnuTrafficSchedulers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) GetOnuTrafficSchedulersById(ctx context.Context, in *OnuIdRequest, opts ...grpc.CallOption) (*ONUTrafficSchedulers, error) {
+	out := new(ONUTrafficSchedulers)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetOnuTrafficSchedulersById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) GetUnis(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*UNIs, error) {
+	out := new(UNIs)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetUnis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) InvalidateMds(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/InvalidateMds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) DisableOnuRediscovery(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/DisableOnuRediscovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) EnableOnuRediscovery(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/EnableOnuRediscovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) SetOnuOmciFault(ctx context.Context, in *OmciFaultRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/SetOnuOmciFault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) ClearOnuOmciFaults(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/ClearOnuOmciFaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) SetOnuOmciResponseLatency(ctx context.Context, in *OnuLatencyRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/SetOnuOmciResponseLatency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) SetOnuDistance(ctx context.Context, in *OnuDistanceRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/SetOnuDistance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) GetBossConfig(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BossConfigSnapshot, error) {
+	out := new(BossConfigSnapshot)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetBossConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) RebootOltWithMode(ctx context.Context, in *OltRebootRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/RebootOltWithMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) GetOnuOmciQueue(ctx context.Context, in *ONURequest, opts ...grpc.CallOption) (*OnuOmciQueue, error) {
+	out := new(OnuOmciQueue)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/GetOnuOmciQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) SetOnuMeSupport(ctx context.Context, in *OnuMeSupportRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/SetOnuMeSupport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) SetOnuAal5PmCounters(ctx context.Context, in *OnuAal5PmCountersRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bbsim.BBSim/SetOnuAal5PmCounters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSimClient) SubscribeEvents(ctx context.Context, in *Empty, opts ...grpc.CallOption) (BBSim_SubscribeEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BBSim_serviceDesc.Streams[0], "/bbsim.BBSim/SubscribeEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bBSimSubscribeEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BBSim_SubscribeEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type bBSimSubscribeEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bBSimSubscribeEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// BBSimServer is the server API for BBSim service.
+type BBSimServer interface {
+	// Get BBSim version
+	Version(context.Context, *Empty) (*VersionNumber, error)
+	// Set BBSim log level
+	SetLogLevel(context.Context, *LogLevel) (*LogLevel, error)
+	// Get current status of OLT
+	GetOlt(context.Context, *Empty) (*Olt, error)
+	GetOltAllocatedResources(context.Context, *OltAllocatedResourceType) (*OltAllocatedResources, error)
+	// Get aggregate alloc-id and gem-port utilization per PON port
+	GetPonResourceUtilization(context.Context, *Empty) (*PonResourceUtilization, error)
+	// Poweron OLT
+	PoweronOlt(context.Context, *Empty) (*Response, error)
+	// Shutdown OLT
+	ShutdownOlt(context.Context, *Empty) (*Response, error)
+	// Disable every ONU and then the OLT for a clean full teardown
+	DrainAndDisableOlt(context.Context, *Empty) (*Response, error)
+	// Reboot OLT
+	RebootOlt(context.Context, *Empty) (*Response, error)
+	// Dump the provisioned OLT state (ONU records, flows, BOSS config) as JSON
+	SnapshotOltState(context.Context, *Empty) (*OltSnapshot, error)
+	// Restore a dump produced by SnapshotOltState, rebuilding the resource maps
+	RestoreOltState(context.Context, *OltSnapshot) (*Response, error)
+	// Change the VLAN on which DHCP packets are trapped at the NNI
+	SetNniDhcpTrapVid(context.Context, *NniDhcpTrapVidRequest) (*Response, error)
+	// Flap an NNI: it goes operationally down and back up after DownDurationMs
+	FlapNNI(context.Context, *NNIFlapRequest) (*Response, error)
+	// Abort an NNI flap in progress, bringing the NNI back up immediately
+	CancelNNIFlap(context.Context, *NNIFlapRequest) (*Response, error)
+	// Test helper: jump the simulated time-of-day forward without waiting
+	AdvanceTod(context.Context, *AdvanceTodRequest) (*Response, error)
+	ClearFlows(context.Context, *ClearFlowsRequest) (*Response, error)
+	SetPonTechnology(context.Context, *PonTechnologyRequest) (*Response, error)
+	// Closes the Openolt gRPC server
+	StopgRPCServer(context.Context, *Empty) (*Response, error)
+	// Start the Openolt gRPC server
+	StartgRPCServer(context.Context, *Empty) (*Response, error)
+	// Restart the Openolt gRPC server after the given timeout
+	RestartgRPCServer(context.Context, *Timeout) (*Response, error)
+	// Make the OLT fail HeartbeatCheck calls for the given number of seconds
+	InjectHeartbeatFailure(context.Context, *Timeout) (*Response, error)
+	// Replace the OLT signature without a reboot to force a signature
+	// mismatch on the next HeartbeatCheck, 0 picks a fresh one
+	SetOltSignature(context.Context, *SignatureRequest) (*Response, error)
+	// Inject a fixed BIP error count in the port stats of an interface,
+	// regardless of the latency flag. A count of 0 clears the injection.
+	SetBipErrorInjection(context.Context, *BipErrorRequest) (*Response, error)
+	// Get status of an ONU by serial number
+	GetONU(context.Context, *ONURequest) (*ONU, error)
+	// Get status of all ONUs
+	GetONUs(context.Context, *Empty) (*ONUs, error)
+	// Get the ONU count summary for all PON ports
+	GetPonOnuSummaries(context.Context, *Empty) (*PonOnuSummaries, error)
+	// Get the ONU count summary for a PON port by pon-port-ID
+	GetPonOnuSummary(context.Context, *PONRequest) (*PonOnuSummary, error)
+	// Get a lock-consistent snapshot of all the device counters
+	GetStatsSnapshot(context.Context, *Empty) (*StatsSnapshot, error)
+	// Get all the Services by Uni ID
+	GetServices(context.Context, *UNIRequest) (*Services, error)
+	// Add a configured service to an ONU UNI at runtime
+	AddOnuService(context.Context, *OnuServiceRequest) (*Response, error)
+	// Remove a service from an ONU UNI at runtime
+	RemoveOnuService(context.Context, *OnuServiceRequest) (*Response, error)
+	// Get all the UNIs of an ONU by serial number
+	GetOnuUnis(context.Context, *ONURequest) (*UNIs, error)
+	// Shutdown an ONU by serial number
+	ShutdownONU(context.Context, *ONURequest) (*Response, error)
+	// Shutdown all ONUs in OLT
+	ShutdownAllONUs(context.Context, *Empty) (*Response, error)
+	// Shutdown all ONUs under a PON by pon-port-ID
+	ShutdownONUsOnPON(context.Context, *PONRequest) (*Response, error)
+	// Simulate an ONU power loss: dying-gasp then down, recoverable via PoweronONU
+	SimulateOnuPowerLoss(context.Context, *PowerLossRequest) (*Response, error)
+	// Reboot an ONU: it goes down, waits DelayMs and powers back on by itself
+	RebootONU(context.Context, *OnuRebootRequest) (*Response, error)
+	// Abort a pending ONU reboot before the ONU powers back on
+	CancelONUReboot(context.Context, *ONURequest) (*Response, error)
+	// Poweron an ONU by serial number
+	PoweronONU(context.Context, *ONURequest) (*Response, error)
+	// Poweron all ONUs in OLT
+	PoweronAllONUs(context.Context, *Empty) (*Response, error)
+	// Poweron all ONUs under a PON by pon-port-ID
+	PoweronONUsOnPON(context.Context, *PONRequest) (*Response, error)
+	// Activate many ONUs in one call with optional pacing, per-ONU results
+	ActivateONUs(context.Context, *BulkActivationRequest) (*BulkActivationResponse, error)
+	// Restart EAPOL for ONU
+	RestartEapol(context.Context, *UNIRequest) (*Response, error)
+	// Restart DHCP for ONU
+	RestartDhcp(context.Context, *UNIRequest) (*Response, error)
+	// Send ONU alarm indication
+	SetOnuAlarmIndication(context.Context, *ONUAlarmRequest) (*Response, error)
+	// Send the same ONU alarm indication on every ONU matching the filters
+	SetBulkOnuAlarmIndication(context.Context, *BulkOnuAlarmRequest) (*Response, error)
+	// Raise or clear an OMCI alarm of an ME that declares an alarm map
+	SetOnuMeAlarmIndication(context.Context, *MeAlarmRequest) (*Response, error)
+	// Send OLT alarm indication for Interface type NNI or PON
+	SetOltAlarmIndication(context.Context, *OLTAlarmRequest) (*Response, error)
+	// Get all flows or ONU specific flows
+	GetFlows(context.Context, *ONURequest) (*Flows, error)
+	// Change IGMP state
+	ChangeIgmpState(context.Context, *IgmpRequest) (*Response, error)
+	// Get Traffic scheduler information for ONU
+	GetOnuTrafficSchedulers(context.Context, *ONURequest) (*ONUTrafficSchedulers, error)
+	// Get the Traffic schedulers installed on an ONU by intf/onu id,
+	// errors if the ONU is unknown or no schedulers are installed
+	GetOnuTrafficSchedulersById(context.Context, *OnuIdRequest) (*ONUTrafficSchedulers, error)
+	//Return all the UNIs for all the ONUs
+	GetUnis(context.Context, *Empty) (*UNIs, error)
+	//Invalidate the MDS counter for the ONU
+	InvalidateMds(context.Context, *ONURequest) (*Response, error)
+	// Suppress the automatic re-discovery of an ONU after delete/deactivate
+	DisableOnuRediscovery(context.Context, *ONURequest) (*Response, error)
+	// Resume the automatic re-discovery of an ONU after delete/deactivate
+	EnableOnuRediscovery(context.Context, *ONURequest) (*Response, error)
+	// Inject a fault on a specific OMCI message type for an ONU
+	SetOnuOmciFault(context.Context, *OmciFaultRequest) (*Response, error)
+	// Clear every injected OMCI fault on an ONU
+	ClearOnuOmciFaults(context.Context, *ONURequest) (*Response, error)
+	// Set the latency applied before every OMCI response of an ONU
+	SetOnuOmciResponseLatency(context.Context, *OnuLatencyRequest) (*Response, error)
+	SetOnuDistance(context.Context, *OnuDistanceRequest) (*Response, error)
+	GetBossConfig(context.Context, *Empty) (*BossConfigSnapshot, error)
+	RebootOltWithMode(context.Context, *OltRebootRequest) (*Response, error)
+	// Inspect the OMCI messages pending on an ONU channel
+	GetOnuOmciQueue(context.Context, *ONURequest) (*OnuOmciQueue, error)
+	// Mark an OMCI managed entity as supported or unsupported on an ONU
+	SetOnuMeSupport(context.Context, *OnuMeSupportRequest) (*Response, error)
+	// Inject AAL5 PM history counters on an ONU
+	SetOnuAal5PmCounters(context.Context, *OnuAal5PmCountersRequest) (*Response, error)
+	// Subscribe to the stream of events published by the simulator
+	SubscribeEvents(*Empty, BBSim_SubscribeEventsServer) error
+}
+
+// UnimplementedBBSimServer can be embedded to have forward compatible implementations.
+type UnimplementedBBSimServer struct {
+}
+
+func (*UnimplementedBBSimServer) Version(ctx context.Context, req *Empty) (*VersionNumber, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+}
+func (*UnimplementedBBSimServer) SetLogLevel(ctx context.Context, req *LogLevel) (*LogLevel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (*UnimplementedBBSimServer) GetOlt(ctx context.Context, req *Empty) (*Olt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOlt not implemented")
+}
+func (*UnimplementedBBSimServer) GetOltAllocatedResources(ctx context.Context, req *OltAllocatedResourceType) (*OltAllocatedResources, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOltAllocatedResources not implemented")
+}
+func (*UnimplementedBBSimServer) GetPonResourceUtilization(ctx context.Context, req *Empty) (*PonResourceUtilization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPonResourceUtilization not implemented")
+}
+func (*UnimplementedBBSimServer) PoweronOlt(ctx context.Context, req *Empty) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoweronOlt not implemented")
+}
+func (*UnimplementedBBSimServer) ShutdownOlt(ctx context.Context, req *Empty) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShutdownOlt not implemented")
+}
+func (*UnimplementedBBSimServer) DrainAndDisableOlt(ctx context.Context, req *Empty) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainAndDisableOlt not implemented")
+}
+func (*UnimplementedBBSimServer) RebootOlt(ctx context.Context, req *Empty) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebootOlt not implemented")
+}
+func (*UnimplementedBBSimServer) SnapshotOltState(ctx context.Context, req *Empty) (*OltSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotOltState not implemented")
+}
+func (*UnimplementedBBSimServer) RestoreOltState(ctx context.Context, req *OltSnapshot) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreOltState not implemented")
+}
+func (*UnimplementedBBSimServer) FlapNNI(ctx context.Context, req *NNIFlapRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlapNNI not implemented")
+}
+func (*UnimplementedBBSimServer) CancelNNIFlap(ctx context.Context, req *NNIFlapRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelNNIFlap not implemented")
+}
+func (*UnimplementedBBSimServer) AdvanceTod(ctx context.Context, req *AdvanceTodRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdvanceTod not implemented")
+}
+func (*UnimplementedBBSimServer) ClearFlows(ctx context.Context, req *ClearFlowsRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearFlows not implemented")
+}
+func (*UnimplementedBBSimServer) SetPonTechnology(ctx context.Context, req *PonTechnologyRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPonTechnology not implemented")
+}
+func (*UnimplementedBBSimServer) SetNniDhcpTrapVid(ctx context.Context, req *NniDhcpTrapVidRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNniDhcpTrapVid not implemented")
+}
+func (*UnimplementedBBSimServer) StopgRPCServer(ctx context.Context, req *Empty) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopgRPCServer not implemented")
+}
+func (*UnimplementedBBSimServer) StartgRPCServer(ctx context.Context, req *Empty) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartgRPCServer not implemented")
+}
+func (*UnimplementedBBSimServer) RestartgRPCServer(ctx context.Context, req *Timeout) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartgRPCServer not implemented")
+}
+func (*UnimplementedBBSimServer) InjectHeartbeatFailure(ctx context.Context, req *Timeout) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InjectHeartbeatFailure not implemented")
+}
+func (*UnimplementedBBSimServer) SetOltSignature(ctx context.Context, req *SignatureRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOltSignature not implemented")
+}
+func (*UnimplementedBBSimServer) SetBipErrorInjection(ctx context.Context, req *BipErrorRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBipErrorInjection not implemented")
+}
+func (*UnimplementedBBSimServer) GetONU(ctx context.Context, req *ONURequest) (*ONU, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetONU not implemented")
+}
+func (*UnimplementedBBSimServer) GetONUs(ctx context.Context, req *Empty) (*ONUs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetONUs not implemented")
+}
+func (*UnimplementedBBSimServer) GetPonOnuSummaries(ctx context.Context, req *Empty) (*PonOnuSummaries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPonOnuSummaries not implemented")
+}
+func (*UnimplementedBBSimServer) GetPonOnuSummary(ctx context.Context, req *PONRequest) (*PonOnuSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPonOnuSummary not implemented")
+}
+func (*UnimplementedBBSimServer) GetStatsSnapshot(ctx context.Context, req *Empty) (*StatsSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatsSnapshot not implemented")
+}
+func (*UnimplementedBBSimServer) AddOnuService(ctx context.Context, req *OnuServiceRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOnuService not implemented")
+}
+func (*UnimplementedBBSimServer) RemoveOnuService(ctx context.Context, req *OnuServiceRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOnuService not implemented")
+}
+func (*UnimplementedBBSimServer) GetServices(ctx context.Context, req *UNIRequest) (*Services, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServices not implemented")
+}
+func (*UnimplementedBBSimServer) GetOnuUnis(ctx context.Context, req *ONURequest) (*UNIs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnuUnis not implemented")
+}
+func (*UnimplementedBBSimServer) ShutdownONU(ctx context.Context, req *ONURequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShutdownONU not implemented")
+}
+func (*UnimplementedBBSimServer) ShutdownAllONUs(ctx context.Context, req *Empty) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShutdownAllONUs not implemented")
+}
+func (*UnimplementedBBSimServer) ShutdownONUsOnPON(ctx context.Context, req *PONRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShutdownONUsOnPON not implemented")
+}
+func (*UnimplementedBBSimServer) SimulateOnuPowerLoss(ctx context.Context, req *PowerLossRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateOnuPowerLoss not implemented")
+}
+func (*UnimplementedBBSimServer) RebootONU(ctx context.Context, req *OnuRebootRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebootONU not implemented")
+}
+func (*UnimplementedBBSimServer) CancelONUReboot(ctx context.Context, req *ONURequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelONUReboot not implemented")
+}
+func (*UnimplementedBBSimServer) PoweronONU(ctx context.Context, req *ONURequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoweronONU not implemented")
+}
+func (*UnimplementedBBSimServer) PoweronAllONUs(ctx context.Context, req *Empty) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoweronAllONUs not implemented")
+}
+func (*UnimplementedBBSimServer) PoweronONUsOnPON(ctx context.Context, req *PONRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoweronONUsOnPON not implemented")
+}
+func (*UnimplementedBBSimServer) ActivateONUs(ctx context.Context, req *BulkActivationRequest) (*BulkActivationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateONUs not implemented")
+}
+func (*UnimplementedBBSimServer) RestartEapol(ctx context.Context, req *UNIRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartEapol not implemented")
+}
+func (*UnimplementedBBSimServer) RestartDhcp(ctx context.Context, req *UNIRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartDhcp not implemented")
+}
+func (*UnimplementedBBSimServer) SetOnuAlarmIndication(ctx context.Context, req *ONUAlarmRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOnuAlarmIndication not implemented")
+}
+func (*UnimplementedBBSimServer) SetOnuMeAlarmIndication(ctx context.Context, req *MeAlarmRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOnuMeAlarmIndication not implemented")
+}
+func (*UnimplementedBBSimServer) SetBulkOnuAlarmIndication(ctx context.Context, req *BulkOnuAlarmRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBulkOnuAlarmIndication not implemented")
+}
+func (*UnimplementedBBSimServer) SetOltAlarmIndication(ctx context.Context, req *OLTAlarmRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOltAlarmIndication not implemented")
+}
+func (*UnimplementedBBSimServer) GetFlows(ctx context.Context, req *ONURequest) (*Flows, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlows not implemented")
+}
+func (*UnimplementedBBSimServer) ChangeIgmpState(ctx context.Context, req *IgmpRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeIgmpState not implemented")
+}
+func (*UnimplementedBBSimServer) GetOnuTrafficSchedulers(ctx context.Context, req *ONURequest) (*ONUTrafficSchedulers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnuTrafficSchedulers not implemented")
+}
+func (*UnimplementedBBSimServer) GetOnuTrafficSchedulersById(ctx context.Context, req *OnuIdRequest) (*ONUTrafficSchedulers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnuTrafficSchedulersById not implemented")
+}
+func (*UnimplementedBBSimServer) GetUnis(ctx context.Context, req *Empty) (*UNIs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnis not implemented")
+}
+func (*UnimplementedBBSimServer) InvalidateMds(ctx context.Context, req *ONURequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateMds not implemented")
+}
+func (*UnimplementedBBSimServer) DisableOnuRediscovery(ctx context.Context, req *ONURequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableOnuRediscovery not implemented")
+}
+func (*UnimplementedBBSimServer) EnableOnuRediscovery(ctx context.Context, req *ONURequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableOnuRediscovery not implemented")
+}
+func (*UnimplementedBBSimServer) SetOnuOmciFault(ctx context.Context, req *OmciFaultRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOnuOmciFault not implemented")
+}
+func (*UnimplementedBBSimServer) ClearOnuOmciFaults(ctx context.Context, req *ONURequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearOnuOmciFaults not implemented")
+}
+func (*UnimplementedBBSimServer) SetOnuOmciResponseLatency(ctx context.Context, req *OnuLatencyRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOnuOmciResponseLatency not implemented")
+}
+func (*UnimplementedBBSimServer) SetOnuDistance(ctx context.Context, req *OnuDistanceRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOnuDistance not implemented")
+}
+func (*UnimplementedBBSimServer) GetBossConfig(ctx context.Context, req *Empty) (*BossConfigSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBossConfig not implemented")
+}
+func (*UnimplementedBBSimServer) RebootOltWithMode(ctx context.Context, req *OltRebootRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebootOltWithMode not implemented")
+}
+func (*UnimplementedBBSimServer) GetOnuOmciQueue(ctx context.Context, req *ONURequest) (*OnuOmciQueue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnuOmciQueue not implemented")
+}
+func (*UnimplementedBBSimServer) SetOnuMeSupport(ctx context.Context, req *OnuMeSupportRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOnuMeSupport not implemented")
+}
+func (*UnimplementedBBSimServer) SetOnuAal5PmCounters(ctx context.Context, req *OnuAal5PmCountersRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOnuAal5PmCounters not implemented")
+}
+func (*UnimplementedBBSimServer) SubscribeEvents(req *Empty, srv BBSim_SubscribeEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEvents not implemented")
+}
+
+func RegisterBBSimServer(s *grpc.Server, srv BBSimServer) {
+	s.RegisterService(&_BBSim_serviceDesc, srv)
+}
+
+func _BBSim_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).Version(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/Version",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).Version(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogLevel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).SetLogLevel(ctx, req.(*LogLevel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_GetOlt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).GetOlt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/GetOlt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).GetOlt(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_GetOltAllocatedResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OltAllocatedResourceType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).GetOltAllocatedResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/GetOltAllocatedResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).GetOltAllocatedResources(ctx, req.(*OltAllocatedResourceType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_GetPonResourceUtilization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).GetPonResourceUtilization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/GetPonResourceUtilization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).GetPonResourceUtilization(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_PoweronOlt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).PoweronOlt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/PoweronOlt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).PoweronOlt(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_DrainAndDisableOlt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).DrainAndDisableOlt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/DrainAndDisableOlt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).DrainAndDisableOlt(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_ShutdownOlt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).ShutdownOlt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/ShutdownOlt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).ShutdownOlt(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_SnapshotOltState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).SnapshotOltState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/SnapshotOltState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).SnapshotOltState(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_RestoreOltState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OltSnapshot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).RestoreOltState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/RestoreOltState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).RestoreOltState(ctx, req.(*OltSnapshot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_RebootOlt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).RebootOlt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/RebootOlt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).RebootOlt(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_SetNniDhcpTrapVid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NniDhcpTrapVidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).SetNniDhcpTrapVid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/SetNniDhcpTrapVid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).SetNniDhcpTrapVid(ctx, req.(*NniDhcpTrapVidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_FlapNNI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NNIFlapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).FlapNNI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/FlapNNI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).FlapNNI(ctx, req.(*NNIFlapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_CancelNNIFlap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NNIFlapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).CancelNNIFlap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/CancelNNIFlap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).CancelNNIFlap(ctx, req.(*NNIFlapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_AdvanceTod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdvanceTodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).AdvanceTod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/AdvanceTod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).AdvanceTod(ctx, req.(*AdvanceTodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_ClearFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearFlowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).ClearFlows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/ClearFlows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).ClearFlows(ctx, req.(*ClearFlowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_SetPonTechnology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PonTechnologyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).SetPonTechnology(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/SetPonTechnology",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).SetPonTechnology(ctx, req.(*PonTechnologyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_StopgRPCServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).StopgRPCServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/StopgRPCServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).StopgRPCServer(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_StartgRPCServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).StartgRPCServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/StartgRPCServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).StartgRPCServer(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_RestartgRPCServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Timeout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).RestartgRPCServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/RestartgRPCServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).RestartgRPCServer(ctx, req.(*Timeout))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_InjectHeartbeatFailure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Timeout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).InjectHeartbeatFailure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/InjectHeartbeatFailure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).InjectHeartbeatFailure(ctx, req.(*Timeout))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_SetOltSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).SetOltSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/SetOltSignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).SetOltSignature(ctx, req.(*SignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_SetBipErrorInjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BipErrorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).SetBipErrorInjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/SetBipErrorInjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).SetBipErrorInjection(ctx, req.(*BipErrorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedBBSimServer) PoweronAllONUs(ctx context.Context, req *Empty) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoweronAllONUs not implemented")
+
+func _BBSim_GetONU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ONURequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).GetONU(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/GetONU",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).GetONU(ctx, req.(*ONURequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedBBSimServer) PoweronONUsOnPON(ctx context.Context, req *PONRequest) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoweronONUsOnPON not implemented")
+
+func _BBSim_GetONUs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).GetONUs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/GetONUs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).GetONUs(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedBBSimServer) RestartEapol(ctx context.Context, req *UNIRequest) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RestartEapol not implemented")
+
+func _BBSim_GetPonOnuSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).GetPonOnuSummaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/GetPonOnuSummaries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).GetPonOnuSummaries(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedBBSimServer) RestartDhcp(ctx context.Context, req *UNIRequest) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RestartDhcp not implemented")
+
+func _BBSim_GetPonOnuSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PONRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).GetPonOnuSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/GetPonOnuSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).GetPonOnuSummary(ctx, req.(*PONRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedBBSimServer) SetOnuAlarmIndication(ctx context.Context, req *ONUAlarmRequest) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetOnuAlarmIndication not implemented")
+
+func _BBSim_GetStatsSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).GetStatsSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/GetStatsSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).GetStatsSnapshot(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedBBSimServer) SetOltAlarmIndication(ctx context.Context, req *OLTAlarmRequest) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetOltAlarmIndication not implemented")
+
+func _BBSim_AddOnuService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnuServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).AddOnuService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/AddOnuService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).AddOnuService(ctx, req.(*OnuServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedBBSimServer) GetFlows(ctx context.Context, req *ONURequest) (*Flows, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFlows not implemented")
+
+func _BBSim_RemoveOnuService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnuServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).RemoveOnuService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/RemoveOnuService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).RemoveOnuService(ctx, req.(*OnuServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedBBSimServer) ChangeIgmpState(ctx context.Context, req *IgmpRequest) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeIgmpState not implemented")
+
+func _BBSim_GetServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UNIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).GetServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/GetServices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).GetServices(ctx, req.(*UNIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedBBSimServer) GetOnuTrafficSchedulers(ctx context.Context, req *ONURequest) (*ONUTrafficSchedulers, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOnuTrafficSchedulers not implemented")
+
+func _BBSim_GetOnuUnis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ONURequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).GetOnuUnis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/GetOnuUnis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).GetOnuUnis(ctx, req.(*ONURequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedBBSimServer) GetUnis(ctx context.Context, req *Empty) (*UNIs, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUnis not implemented")
+
+func _BBSim_ShutdownONU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ONURequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).ShutdownONU(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/ShutdownONU",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).ShutdownONU(ctx, req.(*ONURequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedBBSimServer) InvalidateMds(ctx context.Context, req *ONURequest) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InvalidateMds not implemented")
+
+func _BBSim_ShutdownAllONUs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).ShutdownAllONUs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/ShutdownAllONUs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).ShutdownAllONUs(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterBBSimServer(s *grpc.Server, srv BBSimServer) {
-	s.RegisterService(&_BBSim_serviceDesc, srv)
+func _BBSim_ShutdownONUsOnPON_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PONRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).ShutdownONUsOnPON(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/ShutdownONUsOnPON",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).ShutdownONUsOnPON(ctx, req.(*PONRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _BBSim_SimulateOnuPowerLoss_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PowerLossRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).Version(ctx, in)
+		return srv.(BBSimServer).SimulateOnuPowerLoss(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/Version",
+		FullMethod: "/bbsim.BBSim/SimulateOnuPowerLoss",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).Version(ctx, req.(*Empty))
+		return srv.(BBSimServer).SimulateOnuPowerLoss(ctx, req.(*PowerLossRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogLevel)
+func _BBSim_RebootONU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnuRebootRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).SetLogLevel(ctx, in)
+		return srv.(BBSimServer).RebootONU(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/SetLogLevel",
+		FullMethod: "/bbsim.BBSim/RebootONU",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).SetLogLevel(ctx, req.(*LogLevel))
+		return srv.(BBSimServer).RebootONU(ctx, req.(*OnuRebootRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_GetOlt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _BBSim_CancelONUReboot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ONURequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).GetOlt(ctx, in)
+		return srv.(BBSimServer).CancelONUReboot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/GetOlt",
+		FullMethod: "/bbsim.BBSim/CancelONUReboot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).GetOlt(ctx, req.(*Empty))
+		return srv.(BBSimServer).CancelONUReboot(ctx, req.(*ONURequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_GetOltAllocatedResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OltAllocatedResourceType)
+func _BBSim_PoweronONU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ONURequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).GetOltAllocatedResources(ctx, in)
+		return srv.(BBSimServer).PoweronONU(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/GetOltAllocatedResources",
+		FullMethod: "/bbsim.BBSim/PoweronONU",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).GetOltAllocatedResources(ctx, req.(*OltAllocatedResourceType))
+		return srv.(BBSimServer).PoweronONU(ctx, req.(*ONURequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_PoweronOlt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _BBSim_PoweronAllONUs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).PoweronOlt(ctx, in)
+		return srv.(BBSimServer).PoweronAllONUs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bbsim.BBSim/PoweronAllONUs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSimServer).PoweronAllONUs(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBSim_PoweronONUsOnPON_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PONRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSimServer).PoweronONUsOnPON(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/PoweronOlt",
+		FullMethod: "/bbsim.BBSim/PoweronONUsOnPON",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).PoweronOlt(ctx, req.(*Empty))
+		return srv.(BBSimServer).PoweronONUsOnPON(ctx, req.(*PONRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_ShutdownOlt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _BBSim_ActivateONUs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkActivationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).ShutdownOlt(ctx, in)
+		return srv.(BBSimServer).ActivateONUs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/ShutdownOlt",
+		FullMethod: "/bbsim.BBSim/ActivateONUs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).ShutdownOlt(ctx, req.(*Empty))
+		return srv.(BBSimServer).ActivateONUs(ctx, req.(*BulkActivationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_RebootOlt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _BBSim_RestartEapol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UNIRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).RebootOlt(ctx, in)
+		return srv.(BBSimServer).RestartEapol(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/RebootOlt",
+		FullMethod: "/bbsim.BBSim/RestartEapol",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).RebootOlt(ctx, req.(*Empty))
+		return srv.(BBSimServer).RestartEapol(ctx, req.(*UNIRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_StopgRPCServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _BBSim_RestartDhcp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UNIRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).StopgRPCServer(ctx, in)
+		return srv.(BBSimServer).RestartDhcp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/StopgRPCServer",
+		FullMethod: "/bbsim.BBSim/RestartDhcp",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).StopgRPCServer(ctx, req.(*Empty))
+		return srv.(BBSimServer).RestartDhcp(ctx, req.(*UNIRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_StartgRPCServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _BBSim_SetOnuAlarmIndication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ONUAlarmRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).StartgRPCServer(ctx, in)
+		return srv.(BBSimServer).SetOnuAlarmIndication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/StartgRPCServer",
+		FullMethod: "/bbsim.BBSim/SetOnuAlarmIndication",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).StartgRPCServer(ctx, req.(*Empty))
+		return srv.(BBSimServer).SetOnuAlarmIndication(ctx, req.(*ONUAlarmRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_RestartgRPCServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Timeout)
+func _BBSim_SetBulkOnuAlarmIndication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkOnuAlarmRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).RestartgRPCServer(ctx, in)
+		return srv.(BBSimServer).SetBulkOnuAlarmIndication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/RestartgRPCServer",
+		FullMethod: "/bbsim.BBSim/SetBulkOnuAlarmIndication",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).RestartgRPCServer(ctx, req.(*Timeout))
+		return srv.(BBSimServer).SetBulkOnuAlarmIndication(ctx, req.(*BulkOnuAlarmRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_GetONU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ONURequest)
+func _BBSim_SetOnuMeAlarmIndication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MeAlarmRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).GetONU(ctx, in)
+		return srv.(BBSimServer).SetOnuMeAlarmIndication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/GetONU",
+		FullMethod: "/bbsim.BBSim/SetOnuMeAlarmIndication",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).GetONU(ctx, req.(*ONURequest))
+		return srv.(BBSimServer).SetOnuMeAlarmIndication(ctx, req.(*MeAlarmRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_GetONUs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _BBSim_SetOltAlarmIndication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OLTAlarmRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).GetONUs(ctx, in)
+		return srv.(BBSimServer).SetOltAlarmIndication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/GetONUs",
+		FullMethod: "/bbsim.BBSim/SetOltAlarmIndication",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).GetONUs(ctx, req.(*Empty))
+		return srv.(BBSimServer).SetOltAlarmIndication(ctx, req.(*OLTAlarmRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_GetServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UNIRequest)
+func _BBSim_GetFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ONURequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).GetServices(ctx, in)
+		return srv.(BBSimServer).GetFlows(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/GetServices",
+		FullMethod: "/bbsim.BBSim/GetFlows",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).GetServices(ctx, req.(*UNIRequest))
+		return srv.(BBSimServer).GetFlows(ctx, req.(*ONURequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_GetOnuUnis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ONURequest)
+func _BBSim_ChangeIgmpState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IgmpRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).GetOnuUnis(ctx, in)
+		return srv.(BBSimServer).ChangeIgmpState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/GetOnuUnis",
+		FullMethod: "/bbsim.BBSim/ChangeIgmpState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).GetOnuUnis(ctx, req.(*ONURequest))
+		return srv.(BBSimServer).ChangeIgmpState(ctx, req.(*IgmpRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_ShutdownONU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ONURequest)
+func _BBSim_GetOnuTrafficSchedulersById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnuIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).ShutdownONU(ctx, in)
+		return srv.(BBSimServer).GetOnuTrafficSchedulersById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/ShutdownONU",
+		FullMethod: "/bbsim.BBSim/GetOnuTrafficSchedulersById",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).ShutdownONU(ctx, req.(*ONURequest))
+		return srv.(BBSimServer).GetOnuTrafficSchedulersById(ctx, req.(*OnuIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_ShutdownAllONUs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _BBSim_GetOnuTrafficSchedulers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ONURequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).ShutdownAllONUs(ctx, in)
+		return srv.(BBSimServer).GetOnuTrafficSchedulers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/ShutdownAllONUs",
+		FullMethod: "/bbsim.BBSim/GetOnuTrafficSchedulers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).ShutdownAllONUs(ctx, req.(*Empty))
+		return srv.(BBSimServer).GetOnuTrafficSchedulers(ctx, req.(*ONURequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_ShutdownONUsOnPON_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PONRequest)
+func _BBSim_GetUnis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).ShutdownONUsOnPON(ctx, in)
+		return srv.(BBSimServer).GetUnis(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/ShutdownONUsOnPON",
+		FullMethod: "/bbsim.BBSim/GetUnis",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).ShutdownONUsOnPON(ctx, req.(*PONRequest))
+		return srv.(BBSimServer).GetUnis(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_PoweronONU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _BBSim_InvalidateMds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ONURequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).PoweronONU(ctx, in)
+		return srv.(BBSimServer).InvalidateMds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/PoweronONU",
+		FullMethod: "/bbsim.BBSim/InvalidateMds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).PoweronONU(ctx, req.(*ONURequest))
+		return srv.(BBSimServer).InvalidateMds(ctx, req.(*ONURequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_PoweronAllONUs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _BBSim_DisableOnuRediscovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ONURequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).PoweronAllONUs(ctx, in)
+		return srv.(BBSimServer).DisableOnuRediscovery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/PoweronAllONUs",
+		FullMethod: "/bbsim.BBSim/DisableOnuRediscovery",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).PoweronAllONUs(ctx, req.(*Empty))
+		return srv.(BBSimServer).DisableOnuRediscovery(ctx, req.(*ONURequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_PoweronONUsOnPON_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PONRequest)
+func _BBSim_EnableOnuRediscovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ONURequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).PoweronONUsOnPON(ctx, in)
+		return srv.(BBSimServer).EnableOnuRediscovery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/PoweronONUsOnPON",
+		FullMethod: "/bbsim.BBSim/EnableOnuRediscovery",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).PoweronONUsOnPON(ctx, req.(*PONRequest))
+		return srv.(BBSimServer).EnableOnuRediscovery(ctx, req.(*ONURequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_RestartEapol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UNIRequest)
+func _BBSim_SetOnuOmciFault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OmciFaultRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).RestartEapol(ctx, in)
+		return srv.(BBSimServer).SetOnuOmciFault(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/RestartEapol",
+		FullMethod: "/bbsim.BBSim/SetOnuOmciFault",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).RestartEapol(ctx, req.(*UNIRequest))
+		return srv.(BBSimServer).SetOnuOmciFault(ctx, req.(*OmciFaultRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_RestartDhcp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UNIRequest)
+func _BBSim_ClearOnuOmciFaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ONURequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).RestartDhcp(ctx, in)
+		return srv.(BBSimServer).ClearOnuOmciFaults(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/RestartDhcp",
+		FullMethod: "/bbsim.BBSim/ClearOnuOmciFaults",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).RestartDhcp(ctx, req.(*UNIRequest))
+		return srv.(BBSimServer).ClearOnuOmciFaults(ctx, req.(*ONURequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_SetOnuAlarmIndication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ONUAlarmRequest)
+func _BBSim_SetOnuOmciResponseLatency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnuLatencyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).SetOnuAlarmIndication(ctx, in)
+		return srv.(BBSimServer).SetOnuOmciResponseLatency(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/SetOnuAlarmIndication",
+		FullMethod: "/bbsim.BBSim/SetOnuOmciResponseLatency",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).SetOnuAlarmIndication(ctx, req.(*ONUAlarmRequest))
+		return srv.(BBSimServer).SetOnuOmciResponseLatency(ctx, req.(*OnuLatencyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_SetOltAlarmIndication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OLTAlarmRequest)
+func _BBSim_SetOnuDistance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnuDistanceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).SetOltAlarmIndication(ctx, in)
+		return srv.(BBSimServer).SetOnuDistance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/SetOltAlarmIndication",
+		FullMethod: "/bbsim.BBSim/SetOnuDistance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).SetOltAlarmIndication(ctx, req.(*OLTAlarmRequest))
+		return srv.(BBSimServer).SetOnuDistance(ctx, req.(*OnuDistanceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_GetFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ONURequest)
+func _BBSim_GetBossConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).GetFlows(ctx, in)
+		return srv.(BBSimServer).GetBossConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/GetFlows",
+		FullMethod: "/bbsim.BBSim/GetBossConfig",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).GetFlows(ctx, req.(*ONURequest))
+		return srv.(BBSimServer).GetBossConfig(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_ChangeIgmpState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IgmpRequest)
+func _BBSim_RebootOltWithMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OltRebootRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).ChangeIgmpState(ctx, in)
+		return srv.(BBSimServer).RebootOltWithMode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/ChangeIgmpState",
+		FullMethod: "/bbsim.BBSim/RebootOltWithMode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).ChangeIgmpState(ctx, req.(*IgmpRequest))
+		return srv.(BBSimServer).RebootOltWithMode(ctx, req.(*OltRebootRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_GetOnuTrafficSchedulers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _BBSim_GetOnuOmciQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ONURequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).GetOnuTrafficSchedulers(ctx, in)
+		return srv.(BBSimServer).GetOnuOmciQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/GetOnuTrafficSchedulers",
+		FullMethod: "/bbsim.BBSim/GetOnuOmciQueue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).GetOnuTrafficSchedulers(ctx, req.(*ONURequest))
+		return srv.(BBSimServer).GetOnuOmciQueue(ctx, req.(*ONURequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_GetUnis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _BBSim_SetOnuMeSupport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnuMeSupportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).GetUnis(ctx, in)
+		return srv.(BBSimServer).SetOnuMeSupport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/GetUnis",
+		FullMethod: "/bbsim.BBSim/SetOnuMeSupport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).GetUnis(ctx, req.(*Empty))
+		return srv.(BBSimServer).SetOnuMeSupport(ctx, req.(*OnuMeSupportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BBSim_InvalidateMds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ONURequest)
+func _BBSim_SetOnuAal5PmCounters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnuAal5PmCountersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BBSimServer).InvalidateMds(ctx, in)
+		return srv.(BBSimServer).SetOnuAal5PmCounters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/bbsim.BBSim/InvalidateMds",
+		FullMethod: "/bbsim.BBSim/SetOnuAal5PmCounters",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BBSimServer).InvalidateMds(ctx, req.(*ONURequest))
+		return srv.(BBSimServer).SetOnuAal5PmCounters(ctx, req.(*OnuAal5PmCountersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BBSim_SubscribeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BBSimServer).SubscribeEvents(m, &bBSimSubscribeEventsServer{stream})
+}
+
+type BBSim_SubscribeEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type bBSimSubscribeEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bBSimSubscribeEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _BBSim_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "bbsim.BBSim",
 	HandlerType: (*BBSimServer)(nil),
@@ -3104,6 +6421,10 @@ var _BBSim_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetOltAllocatedResources",
 			Handler:    _BBSim_GetOltAllocatedResources_Handler,
 		},
+		{
+			MethodName: "GetPonResourceUtilization",
+			Handler:    _BBSim_GetPonResourceUtilization_Handler,
+		},
 		{
 			MethodName: "PoweronOlt",
 			Handler:    _BBSim_PoweronOlt_Handler,
@@ -3112,10 +6433,46 @@ var _BBSim_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShutdownOlt",
 			Handler:    _BBSim_ShutdownOlt_Handler,
 		},
+		{
+			MethodName: "DrainAndDisableOlt",
+			Handler:    _BBSim_DrainAndDisableOlt_Handler,
+		},
 		{
 			MethodName: "RebootOlt",
 			Handler:    _BBSim_RebootOlt_Handler,
 		},
+		{
+			MethodName: "SnapshotOltState",
+			Handler:    _BBSim_SnapshotOltState_Handler,
+		},
+		{
+			MethodName: "RestoreOltState",
+			Handler:    _BBSim_RestoreOltState_Handler,
+		},
+		{
+			MethodName: "FlapNNI",
+			Handler:    _BBSim_FlapNNI_Handler,
+		},
+		{
+			MethodName: "CancelNNIFlap",
+			Handler:    _BBSim_CancelNNIFlap_Handler,
+		},
+		{
+			MethodName: "AdvanceTod",
+			Handler:    _BBSim_AdvanceTod_Handler,
+		},
+		{
+			MethodName: "ClearFlows",
+			Handler:    _BBSim_ClearFlows_Handler,
+		},
+		{
+			MethodName: "SetPonTechnology",
+			Handler:    _BBSim_SetPonTechnology_Handler,
+		},
+		{
+			MethodName: "SetNniDhcpTrapVid",
+			Handler:    _BBSim_SetNniDhcpTrapVid_Handler,
+		},
 		{
 			MethodName: "StopgRPCServer",
 			Handler:    _BBSim_StopgRPCServer_Handler,
@@ -3128,6 +6485,18 @@ var _BBSim_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RestartgRPCServer",
 			Handler:    _BBSim_RestartgRPCServer_Handler,
 		},
+		{
+			MethodName: "InjectHeartbeatFailure",
+			Handler:    _BBSim_InjectHeartbeatFailure_Handler,
+		},
+		{
+			MethodName: "SetOltSignature",
+			Handler:    _BBSim_SetOltSignature_Handler,
+		},
+		{
+			MethodName: "SetBipErrorInjection",
+			Handler:    _BBSim_SetBipErrorInjection_Handler,
+		},
 		{
 			MethodName: "GetONU",
 			Handler:    _BBSim_GetONU_Handler,
@@ -3136,10 +6505,30 @@ var _BBSim_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetONUs",
 			Handler:    _BBSim_GetONUs_Handler,
 		},
+		{
+			MethodName: "GetPonOnuSummaries",
+			Handler:    _BBSim_GetPonOnuSummaries_Handler,
+		},
+		{
+			MethodName: "GetPonOnuSummary",
+			Handler:    _BBSim_GetPonOnuSummary_Handler,
+		},
+		{
+			MethodName: "GetStatsSnapshot",
+			Handler:    _BBSim_GetStatsSnapshot_Handler,
+		},
 		{
 			MethodName: "GetServices",
 			Handler:    _BBSim_GetServices_Handler,
 		},
+		{
+			MethodName: "AddOnuService",
+			Handler:    _BBSim_AddOnuService_Handler,
+		},
+		{
+			MethodName: "RemoveOnuService",
+			Handler:    _BBSim_RemoveOnuService_Handler,
+		},
 		{
 			MethodName: "GetOnuUnis",
 			Handler:    _BBSim_GetOnuUnis_Handler,
@@ -3156,6 +6545,18 @@ var _BBSim_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShutdownONUsOnPON",
 			Handler:    _BBSim_ShutdownONUsOnPON_Handler,
 		},
+		{
+			MethodName: "SimulateOnuPowerLoss",
+			Handler:    _BBSim_SimulateOnuPowerLoss_Handler,
+		},
+		{
+			MethodName: "RebootONU",
+			Handler:    _BBSim_RebootONU_Handler,
+		},
+		{
+			MethodName: "CancelONUReboot",
+			Handler:    _BBSim_CancelONUReboot_Handler,
+		},
 		{
 			MethodName: "PoweronONU",
 			Handler:    _BBSim_PoweronONU_Handler,
@@ -3168,6 +6569,10 @@ var _BBSim_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PoweronONUsOnPON",
 			Handler:    _BBSim_PoweronONUsOnPON_Handler,
 		},
+		{
+			MethodName: "ActivateONUs",
+			Handler:    _BBSim_ActivateONUs_Handler,
+		},
 		{
 			MethodName: "RestartEapol",
 			Handler:    _BBSim_RestartEapol_Handler,
@@ -3180,6 +6585,14 @@ var _BBSim_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetOnuAlarmIndication",
 			Handler:    _BBSim_SetOnuAlarmIndication_Handler,
 		},
+		{
+			MethodName: "SetBulkOnuAlarmIndication",
+			Handler:    _BBSim_SetBulkOnuAlarmIndication_Handler,
+		},
+		{
+			MethodName: "SetOnuMeAlarmIndication",
+			Handler:    _BBSim_SetOnuMeAlarmIndication_Handler,
+		},
 		{
 			MethodName: "SetOltAlarmIndication",
 			Handler:    _BBSim_SetOltAlarmIndication_Handler,
@@ -3196,6 +6609,10 @@ var _BBSim_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetOnuTrafficSchedulers",
 			Handler:    _BBSim_GetOnuTrafficSchedulers_Handler,
 		},
+		{
+			MethodName: "GetOnuTrafficSchedulersById",
+			Handler:    _BBSim_GetOnuTrafficSchedulersById_Handler,
+		},
 		{
 			MethodName: "GetUnis",
 			Handler:    _BBSim_GetUnis_Handler,
@@ -3204,7 +6621,57 @@ var _BBSim_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InvalidateMds",
 			Handler:    _BBSim_InvalidateMds_Handler,
 		},
+		{
+			MethodName: "DisableOnuRediscovery",
+			Handler:    _BBSim_DisableOnuRediscovery_Handler,
+		},
+		{
+			MethodName: "EnableOnuRediscovery",
+			Handler:    _BBSim_EnableOnuRediscovery_Handler,
+		},
+		{
+			MethodName: "SetOnuOmciFault",
+			Handler:    _BBSim_SetOnuOmciFault_Handler,
+		},
+		{
+			MethodName: "ClearOnuOmciFaults",
+			Handler:    _BBSim_ClearOnuOmciFaults_Handler,
+		},
+		{
+			MethodName: "SetOnuOmciResponseLatency",
+			Handler:    _BBSim_SetOnuOmciResponseLatency_Handler,
+		},
+		{
+			MethodName: "SetOnuDistance",
+			Handler:    _BBSim_SetOnuDistance_Handler,
+		},
+		{
+			MethodName: "GetBossConfig",
+			Handler:    _BBSim_GetBossConfig_Handler,
+		},
+		{
+			MethodName: "RebootOltWithMode",
+			Handler:    _BBSim_RebootOltWithMode_Handler,
+		},
+		{
+			MethodName: "GetOnuOmciQueue",
+			Handler:    _BBSim_GetOnuOmciQueue_Handler,
+		},
+		{
+			MethodName: "SetOnuMeSupport",
+			Handler:    _BBSim_SetOnuMeSupport_Handler,
+		},
+		{
+			MethodName: "SetOnuAal5PmCounters",
+			Handler:    _BBSim_SetOnuAal5PmCounters_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeEvents",
+			Handler:       _BBSim_SubscribeEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/bbsim/bbsim.proto",
 }
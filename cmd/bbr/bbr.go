@@ -70,11 +70,14 @@ func main() {
 	}).Info("BroadBand Reflector is on")
 
 	// create the OLT device
-	olt := devices.CreateOLT(
+	olt, err := devices.CreateOLT(
 		*config.GlobalConfig,
 		common.Services,
 		true,
 	)
+	if err != nil {
+		log.Fatalf("Can't create OLT: %v", err)
+	}
 
 	onuIdMap := make(map[uint32]uint32, config.Olt.PonPorts)
 
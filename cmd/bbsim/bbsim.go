@@ -66,6 +66,34 @@ func startApiServer(apiDoneChannel chan bool, group *sync.WaitGroup) {
 	group.Done()
 }
 
+// startControlApiServer starts the optional out-of-band control listener. It
+// serves the same BBSim control/test API as startApiServer on a dedicated
+// address, so test tooling can inject alarms, reboot ONUs or query state
+// without sharing a channel with the controller-facing OpenOLT interface.
+func startControlApiServer(apiDoneChannel chan bool, group *sync.WaitGroup) {
+	address := common.Config.BBSim.ControlApiAddress
+	log.Debugf("Control APIServer listening on %v", address)
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		log.Fatalf("Control APIServer failed to listen: %v", err)
+	}
+	grpcServer := grpc.NewServer()
+	bbsim.RegisterBBSimServer(grpcServer, api.BBSimServer{})
+
+	reflection.Register(grpcServer)
+
+	go func() { _ = grpcServer.Serve(lis) }()
+
+	x := <-apiDoneChannel
+	if x {
+		// if the API channel is closed, stop the gRPC server
+		grpcServer.Stop()
+		log.Warnf("Stopping control API gRPC server")
+	}
+
+	group.Done()
+}
+
 // startApiRestServer method starts the REST server (grpc gateway) for BBSim.
 func startApiRestServer(apiDoneChannel chan bool, group *sync.WaitGroup, grpcAddress string) {
 	ctx := context.Background()
@@ -134,6 +162,7 @@ func main() {
 	common.LoadConfig()
 
 	common.SetLogLevel(log.StandardLogger(), common.Config.BBSim.LogLevel, common.Config.BBSim.LogCaller)
+	devices.ConfigureDeviceLogging(common.Config.BBSim.LogLevel, common.Config.BBSim.LogCaller, common.Config.BBSim.LogDeviceJson)
 
 	if *common.Config.BBSim.CpuProfile != "" {
 		// start profiling
@@ -170,11 +199,14 @@ func main() {
 	// control channels, they are only closed when the goroutine needs to be terminated
 	apiDoneChannel := make(chan bool)
 
-	olt := devices.CreateOLT(
+	olt, err := devices.CreateOLT(
 		*common.Config,
 		common.Services,
 		false,
 	)
+	if err != nil {
+		log.Fatalf("Can't create OLT: %v", err)
+	}
 
 	log.Debugf("Created OLT with id: %d", common.Config.Olt.ID)
 
@@ -192,6 +224,10 @@ func main() {
 
 	go startApiServer(apiDoneChannel, &wg)
 	go startLegacyApiServer(apiDoneChannel, &wg)
+	if common.Config.BBSim.ControlApiAddress != "" {
+		wg.Add(1)
+		go startControlApiServer(apiDoneChannel, &wg)
+	}
 	log.Debugf("Started APIService")
 	if common.Config.BBSim.SadisServer {
 		wg.Add(1)
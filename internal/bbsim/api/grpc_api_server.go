@@ -27,6 +27,7 @@ import (
 	"github.com/opencord/bbsim/api/bbsim"
 	"github.com/opencord/bbsim/internal/bbsim/devices"
 	"github.com/opencord/bbsim/internal/common"
+	me "github.com/opencord/omci-lib-go/v2/generated"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 )
@@ -156,6 +157,70 @@ func resourcesMapToresourcesProto(resourceType bbsim.OltAllocatedResourceType_Ty
 	return proto
 }
 
+// takes a PON port and returns its ONU count summary
+func ponOnuSummaryToProto(pon *devices.PonPort) *bbsim.PonOnuSummary {
+	summary := &bbsim.PonOnuSummary{
+		PonPortId:  pon.ID,
+		TotalOnus:  uint32(len(pon.Onus)),
+		ActiveOnus: pon.GetNumOfActiveOnus(),
+		Onus:       []*bbsim.PonOnuStatus{},
+	}
+	for _, onu := range pon.Onus {
+		summary.Onus = append(summary.Onus, &bbsim.PonOnuStatus{
+			OnuId:         onu.ID,
+			SerialNumber:  onu.Sn(),
+			InternalState: onu.InternalState.Current(),
+			OperState:     onu.OperState.Current(),
+		})
+	}
+	return summary
+}
+
+func (s BBSimServer) GetPonOnuSummaries(ctx context.Context, req *bbsim.Empty) (*bbsim.PonOnuSummaries, error) {
+	olt := devices.GetOLT()
+	summaries := &bbsim.PonOnuSummaries{
+		Pons: []*bbsim.PonOnuSummary{},
+	}
+	for _, pon := range olt.Pons {
+		summaries.Pons = append(summaries.Pons, ponOnuSummaryToProto(pon))
+	}
+	return summaries, nil
+}
+
+func (s BBSimServer) GetPonOnuSummary(ctx context.Context, req *bbsim.PONRequest) (*bbsim.PonOnuSummary, error) {
+	olt := devices.GetOLT()
+	pon, err := olt.GetPonById(req.PonPortId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "cannot-find-pon-with-id-%d", req.PonPortId)
+	}
+	return ponOnuSummaryToProto(pon), nil
+}
+
+func (s BBSimServer) GetStatsSnapshot(ctx context.Context, req *bbsim.Empty) (*bbsim.StatsSnapshot, error) {
+	olt := devices.GetOLT()
+	snapshot := olt.TakeStatsSnapshot()
+	res := &bbsim.StatsSnapshot{
+		PktInCount:    snapshot.PktInCount,
+		DroppedEvents: snapshot.DroppedEvents,
+		Nnis:          []*bbsim.PortCounters{},
+		Pons:          []*bbsim.PortCounters{},
+	}
+	for _, nni := range snapshot.Nnis {
+		res.Nnis = append(res.Nnis, &bbsim.PortCounters{
+			PortId:      nni.ID,
+			PacketCount: nni.PacketCount,
+		})
+	}
+	for _, pon := range snapshot.Pons {
+		res.Pons = append(res.Pons, &bbsim.PortCounters{
+			PortId:         pon.ID,
+			PacketCount:    pon.PacketCount,
+			DroppedPackets: pon.DroppedPackets,
+		})
+	}
+	return res, nil
+}
+
 func (s BBSimServer) GetOltAllocatedResources(ctx context.Context, req *bbsim.OltAllocatedResourceType) (*bbsim.OltAllocatedResources, error) {
 	o := devices.GetOLT()
 
@@ -171,6 +236,35 @@ func (s BBSimServer) GetOltAllocatedResources(ctx context.Context, req *bbsim.Ol
 	}
 }
 
+func (s BBSimServer) GetPonResourceUtilization(ctx context.Context, req *bbsim.Empty) (*bbsim.PonResourceUtilization, error) {
+	o := devices.GetOLT()
+
+	res := &bbsim.PonResourceUtilization{
+		Pons: []*bbsim.PonResourceUsage{},
+	}
+
+	for _, pon := range o.Pons {
+		allocIds, gemPorts := o.PonResourceUsage(pon)
+
+		usage := &bbsim.PonResourceUsage{
+			PonPortId:    pon.ID,
+			AllocIdsUsed: uint32(allocIds),
+			GemPortsUsed: uint32(gemPorts),
+		}
+
+		ponConf, err := common.GetPonConfigById(pon.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "no-pon-configuration-for-port-%d", pon.ID)
+		}
+		usage.AllocIdsTotal = ponConf.AllocIdRange.EndId - ponConf.AllocIdRange.StartId + 1
+		usage.GemPortsTotal = ponConf.GemportRange.EndId - ponConf.GemportRange.StartId + 1
+
+		res.Pons = append(res.Pons, usage)
+	}
+
+	return res, nil
+}
+
 func (s BBSimServer) PoweronOlt(ctx context.Context, req *bbsim.Empty) (*bbsim.Response, error) {
 	res := &bbsim.Response{}
 	o := devices.GetOLT()
@@ -199,6 +293,22 @@ func (s BBSimServer) ShutdownOlt(ctx context.Context, req *bbsim.Empty) (*bbsim.
 	return res, nil
 }
 
+// DrainAndDisableOlt explicitly disables every ONU before disabling the
+// OLT, for scenarios that need a deterministic "everything down" state
+func (s BBSimServer) DrainAndDisableOlt(ctx context.Context, req *bbsim.Empty) (*bbsim.Response, error) {
+	res := &bbsim.Response{}
+	o := devices.GetOLT()
+
+	if err := o.DrainAndDisable(); err != nil {
+		log.Errorf("Error draining OLT: %v", err)
+		res.StatusCode = int32(codes.FailedPrecondition)
+		return res, err
+	}
+
+	res.StatusCode = int32(codes.OK)
+	return res, nil
+}
+
 func (s BBSimServer) RebootOlt(ctx context.Context, req *bbsim.Empty) (*bbsim.Response, error) {
 	res := &bbsim.Response{}
 	o := devices.GetOLT()
@@ -208,6 +318,204 @@ func (s BBSimServer) RebootOlt(ctx context.Context, req *bbsim.Empty) (*bbsim.Re
 	return res, nil
 }
 
+func (s BBSimServer) RebootOltWithMode(ctx context.Context, req *bbsim.OltRebootRequest) (*bbsim.Response, error) {
+	res := &bbsim.Response{}
+	o := devices.GetOLT()
+
+	switch req.Mode {
+	case "soft", "hard":
+	default:
+		err := fmt.Errorf("unknown-reboot-mode-%s", req.Mode)
+		res.StatusCode = int32(codes.InvalidArgument)
+		res.Message = err.Error()
+		return res, err
+	}
+	// a soft reboot is only possible while the OLT is enabled, report that
+	// to the caller instead of failing in the background
+	if req.Mode == "soft" && !o.InternalState.Is(devices.OltInternalStateEnabled) {
+		err := fmt.Errorf("olt-must-be-enabled-for-a-soft-reboot-current-state-is-%s", o.InternalState.Current())
+		res.StatusCode = int32(codes.FailedPrecondition)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	go func() { _ = o.RestartOLTWithMode(req.Mode) }()
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("OLT %s restart triggered.", req.Mode)
+	return res, nil
+}
+
+// SnapshotOltState dumps the provisioned OLT state (ONU records, flows,
+// BOSS config) as JSON so a test can store it as a fixture
+func (s BBSimServer) SnapshotOltState(ctx context.Context, req *bbsim.Empty) (*bbsim.OltSnapshot, error) {
+	o := devices.GetOLT()
+
+	data, err := o.DumpProvisionedState()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return &bbsim.OltSnapshot{Snapshot: data}, nil
+}
+
+// RestoreOltState rebuilds the provisioned OLT state from a dump produced by
+// SnapshotOltState, so a test can jump straight to a known provisioned state
+func (s BBSimServer) RestoreOltState(ctx context.Context, req *bbsim.OltSnapshot) (*bbsim.Response, error) {
+	res := &bbsim.Response{}
+	o := devices.GetOLT()
+
+	if err := o.RestoreProvisionedState(req.Snapshot); err != nil {
+		res.StatusCode = int32(codes.InvalidArgument)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	res.StatusCode = int32(codes.OK)
+	res.Message = "OLT state restored."
+	return res, nil
+}
+
+func (s BBSimServer) SetNniDhcpTrapVid(ctx context.Context, req *bbsim.NniDhcpTrapVidRequest) (*bbsim.Response, error) {
+	res := &bbsim.Response{}
+	if req.Vid < 0 || req.Vid > 4095 {
+		res.StatusCode = int32(codes.InvalidArgument)
+		res.Message = fmt.Sprintf("Invalid NNI DHCP trap VID %d", req.Vid)
+		return res, status.Errorf(codes.InvalidArgument, "invalid-nni-dhcp-trap-vid-%d", req.Vid)
+	}
+
+	o := devices.GetOLT()
+	o.NniDhcpTrapVid = int(req.Vid)
+
+	logger.WithFields(log.Fields{
+		"NniDhcpTrapVid": req.Vid,
+	}).Info("NNI DHCP trap VID changed")
+
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("NNI DHCP trap VID set to %d", req.Vid)
+	return res, nil
+}
+
+func (s BBSimServer) FlapNNI(ctx context.Context, req *bbsim.NNIFlapRequest) (*bbsim.Response, error) {
+	res := &bbsim.Response{}
+	o := devices.GetOLT()
+
+	downFor := time.Duration(req.DownDurationMs) * time.Millisecond
+	if err := o.FlapNni(req.NniPortId, downFor); err != nil {
+		res.StatusCode = int32(codes.FailedPrecondition)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("NNI %d is flapping, it will come back up in %s.", req.NniPortId, downFor)
+	return res, nil
+}
+
+func (s BBSimServer) CancelNNIFlap(ctx context.Context, req *bbsim.NNIFlapRequest) (*bbsim.Response, error) {
+	res := &bbsim.Response{}
+	o := devices.GetOLT()
+
+	if err := o.CancelNniFlap(req.NniPortId); err != nil {
+		res.StatusCode = int32(codes.FailedPrecondition)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("NNI %d flap canceled.", req.NniPortId)
+	return res, nil
+}
+
+func (s BBSimServer) AdvanceTod(ctx context.Context, req *bbsim.AdvanceTodRequest) (*bbsim.Response, error) {
+	res := &bbsim.Response{}
+	o := devices.GetOLT()
+
+	o.AdvanceTod(time.Duration(req.Seconds) * time.Second)
+
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("Simulated time-of-day advanced by %ds.", req.Seconds)
+	return res, nil
+}
+
+func (s BBSimServer) SetPonTechnology(ctx context.Context, req *bbsim.PonTechnologyRequest) (*bbsim.Response, error) {
+	res := &bbsim.Response{}
+	o := devices.GetOLT()
+
+	if err := o.SetPonTechnology(req.PonPortId, req.Technology); err != nil {
+		res.StatusCode = int32(codes.InvalidArgument)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("PON %d technology set to %s.", req.PonPortId, req.Technology)
+	return res, nil
+}
+
+func (s BBSimServer) ClearFlows(ctx context.Context, req *bbsim.ClearFlowsRequest) (*bbsim.Response, error) {
+	res := &bbsim.Response{}
+	o := devices.GetOLT()
+
+	ponId := int32(req.PonPortId)
+	onuId := int32(-1)
+	if req.OnuSerialNumber != "" {
+		onu, err := o.FindOnuBySn(req.OnuSerialNumber)
+		if err != nil {
+			res.StatusCode = int32(codes.NotFound)
+			res.Message = err.Error()
+			return res, err
+		}
+		ponId = int32(onu.PonPortID)
+		onuId = int32(onu.ID)
+	}
+
+	removed, err := o.ClearFlows(ponId, onuId)
+	if err != nil {
+		res.StatusCode = int32(codes.FailedPrecondition)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("Cleared %d flows.", removed)
+	return res, nil
+}
+
+func (s BBSimServer) GetBossConfig(ctx context.Context, req *bbsim.Empty) (*bbsim.BossConfigSnapshot, error) {
+	o := devices.GetOLT()
+	snapshot := o.TakeBossConfigSnapshot()
+
+	res := &bbsim.BossConfigSnapshot{
+		Mtu:               snapshot.Mac.Mtu,
+		VlanMode:          snapshot.Mac.VlanMode,
+		AgingMode:         snapshot.Mac.AgingMode,
+		AgingTime:         snapshot.Mac.AgingTime,
+		DelimiterLength:   snapshot.Burst.DelimiterLength,
+		Delimiter:         snapshot.Burst.Delimiter,
+		PreambleLength:    snapshot.Burst.PreambleLength,
+		Preamble:          snapshot.Burst.Preamble,
+		PreambleRepeat:    snapshot.Burst.PreambleRepeat,
+		BurstVersion:      snapshot.Burst.Version,
+		BurstVersionIndex: snapshot.Burst.VersionIndex,
+		Pontag:            snapshot.Burst.Pontag,
+		ErrorPermit:       snapshot.ErrorPermit,
+		FecDecMode:        snapshot.FecDecMode,
+		Slas:              []*bbsim.BossOnuSla{},
+	}
+	for _, sla := range snapshot.Slas {
+		res.Slas = append(res.Slas, &bbsim.BossOnuSla{
+			OnuId: uint32(sla.OnuId),
+			Tcont: sla.Tcont,
+			Type:  sla.Type,
+			Si:    sla.Si,
+			Abmin: sla.Abmin,
+			Absur: sla.Absur,
+		})
+	}
+
+	return res, nil
+}
+
 func (s BBSimServer) StopgRPCServer(ctx context.Context, req *bbsim.Empty) (*bbsim.Response, error) {
 	res := &bbsim.Response{}
 	res.StatusCode = int32(codes.OK)
@@ -266,6 +574,75 @@ func (s BBSimServer) RestartgRPCServer(ctx context.Context, req *bbsim.Timeout)
 	return res, nil
 }
 
+// InjectHeartbeatFailure makes the OLT fail HeartbeatCheck calls for the
+// requested number of seconds, simulating a flaky management channel
+func (s BBSimServer) InjectHeartbeatFailure(ctx context.Context, req *bbsim.Timeout) (*bbsim.Response, error) {
+	o := devices.GetOLT()
+	logger.Infof("Received request to fail HeartbeatCheck for %v seconds", req.Delay)
+
+	o.InjectHeartbeatFailure(time.Duration(req.Delay) * time.Second)
+
+	res := &bbsim.Response{}
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("HeartbeatCheck will fail for %v seconds", req.Delay)
+
+	return res, nil
+}
+
+// SetOltSignature forces the OLT signature to the requested value
+func (s BBSimServer) SetOltSignature(ctx context.Context, req *bbsim.SignatureRequest) (*bbsim.Response, error) {
+	res := &bbsim.Response{}
+	o := devices.GetOLT()
+	signature := o.ForceSignatureChange(req.Signature)
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("OLT signature set to %d", signature)
+	return res, nil
+}
+
+func (s BBSimServer) SetBipErrorInjection(ctx context.Context, req *bbsim.BipErrorRequest) (*bbsim.Response, error) {
+	res := &bbsim.Response{}
+	o := devices.GetOLT()
+	o.SetInjectedBipErrors(req.IntfId, req.BipErrors)
+	res.StatusCode = int32(codes.OK)
+	if req.BipErrors == 0 {
+		res.Message = fmt.Sprintf("Cleared BIP error injection on interface %d", req.IntfId)
+	} else {
+		res.Message = fmt.Sprintf("Injecting %d BIP errors on interface %d", req.BipErrors, req.IntfId)
+	}
+	return res, nil
+}
+
+// SubscribeEvents streams the events published by the simulator to the
+// client, each subscriber receives its own copy of every event
+func (s BBSimServer) SubscribeEvents(req *bbsim.Empty, stream bbsim.BBSim_SubscribeEventsServer) error {
+	o := devices.GetOLT()
+
+	events := o.SubscribeEvents()
+	defer o.UnsubscribeEvents(events)
+
+	logger.Info("Client subscribed to the event stream")
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			logger.Info("Event stream subscriber disconnected")
+			return nil
+		case event := <-events:
+			if err := stream.Send(&bbsim.Event{
+				EventType: event.EventType,
+				OnuSerial: event.OnuSerial,
+				OltID:     int32(event.OltID),
+				IntfID:    event.IntfID,
+				OnuID:     event.OnuID,
+				EpochTime: event.EpochTime,
+				Timestamp: event.Timestamp,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func (s BBSimServer) SetLogLevel(ctx context.Context, req *bbsim.LogLevel) (*bbsim.LogLevel, error) {
 
 	common.SetLogLevel(log.StandardLogger(), req.Level, req.Caller)
@@ -299,6 +676,82 @@ func (s BBSimServer) SetOnuAlarmIndication(ctx context.Context, req *bbsim.ONUAl
 	return res, nil
 }
 
+// SetBulkOnuAlarmIndication raises the same alarm on every ONU matching the
+// request filters, simulating e.g. a fiber cut affecting many subscribers at once
+func (s BBSimServer) SetBulkOnuAlarmIndication(ctx context.Context, req *bbsim.BulkOnuAlarmRequest) (*bbsim.Response, error) {
+	o := devices.GetOLT()
+
+	res := &bbsim.Response{}
+
+	raised := 0
+	failed := 0
+	for _, pon := range o.Pons {
+		if req.PonPortId >= 0 && uint32(req.PonPortId) != pon.ID {
+			continue
+		}
+		for _, onu := range pon.Onus {
+			if req.SerialNumberPrefix != "" && !strings.HasPrefix(onu.Sn(), req.SerialNumberPrefix) {
+				continue
+			}
+			if req.InternalState != "" && onu.InternalState.Current() != req.InternalState {
+				continue
+			}
+			if err := onu.SetAlarm(req.AlarmType, req.Status); err != nil {
+				logger.WithFields(log.Fields{
+					"OnuSn":     onu.Sn(),
+					"AlarmType": req.AlarmType,
+					"Err":       err,
+				}).Error("cannot-raise-alarm-on-onu")
+				failed++
+				continue
+			}
+			raised++
+		}
+	}
+
+	if raised == 0 && failed > 0 {
+		res.StatusCode = int32(codes.Internal)
+		res.Message = fmt.Sprintf("Cannot raise alarm on any of the %d matching ONUs", failed)
+		return res, status.Errorf(codes.Internal, "%s", res.Message)
+	}
+
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("Onu Alarm Indication Sent to %d ONUs (%d failed).", raised, failed)
+	return res, nil
+}
+
+// SetOnuMeAlarmIndication raises or clears an OMCI alarm bit of an ME
+// instance that declares an alarm map, clearing a non-raised alarm is a no-op
+func (s BBSimServer) SetOnuMeAlarmIndication(ctx context.Context, req *bbsim.MeAlarmRequest) (*bbsim.Response, error) {
+	o := devices.GetOLT()
+
+	res := &bbsim.Response{}
+
+	onu, err := o.FindOnuBySn(req.SerialNumber)
+	if err != nil {
+		res.StatusCode = int32(codes.NotFound)
+		res.Message = err.Error()
+		return nil, err
+	}
+
+	if req.Status != "on" && req.Status != "off" {
+		err := fmt.Errorf("invalid-alarm-status-%s", req.Status)
+		res.StatusCode = int32(codes.InvalidArgument)
+		res.Message = err.Error()
+		return nil, err
+	}
+
+	if err := onu.SetMeAlarm(me.ClassID(req.MeClassId), uint16(req.EntityInstance), uint8(req.AlarmBit), req.Status == "on"); err != nil {
+		res.StatusCode = int32(codes.Internal)
+		res.Message = err.Error()
+		return nil, err
+	}
+
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("Onu ME Alarm Indication Sent.")
+	return res, nil
+}
+
 // SetOltAlarmIndication generates OLT Alarms for LOS
 func (s BBSimServer) SetOltAlarmIndication(ctx context.Context, req *bbsim.OLTAlarmRequest) (*bbsim.Response, error) {
 	o := devices.GetOLT()
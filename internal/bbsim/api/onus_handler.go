@@ -20,14 +20,20 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"sync/atomic"
+	"time"
 
 	"github.com/opencord/bbsim/internal/bbsim/types"
+	omcilib "github.com/opencord/bbsim/internal/common/omci"
+	me "github.com/opencord/omci-lib-go/v2/generated"
 	"github.com/opencord/voltha-protos/v5/go/openolt"
 
 	"github.com/opencord/bbsim/api/bbsim"
 	"github.com/opencord/bbsim/internal/bbsim/devices"
+	"github.com/opencord/bbsim/internal/common"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (s BBSimServer) GetONUs(ctx context.Context, req *bbsim.Empty) (*bbsim.ONUs, error) {
@@ -49,6 +55,8 @@ func (s BBSimServer) GetONUs(ctx context.Context, req *bbsim.Empty) (*bbsim.ONUs
 				ActiveImageEntityId:           int32(o.ActiveImageEntityId),
 				CommittedImageEntityId:        int32(o.CommittedImageEntityId),
 				Unis:                          append(convertBBsimUniPortsToProtoUniPorts(o.UniPorts), convertBBsimPotsPortsToProtoUniPorts(o.PotsPorts)...),
+				LastStateChange:               formatLastStateChange(o),
+				UptimeSeconds:                 uint64(o.Uptime().Seconds()),
 			}
 			onus.Items = append(onus.Items, &onu)
 		}
@@ -66,16 +74,28 @@ func (s BBSimServer) GetONU(ctx context.Context, req *bbsim.ONURequest) (*bbsim.
 	}
 
 	res := bbsim.ONU{
-		ID:            int32(onu.ID),
-		SerialNumber:  onu.Sn(),
-		OperState:     onu.OperState.Current(),
-		InternalState: onu.InternalState.Current(),
-		PonPortID:     int32(onu.PonPortID),
-		Unis:          append(convertBBsimUniPortsToProtoUniPorts(onu.UniPorts), convertBBsimPotsPortsToProtoUniPorts(onu.PotsPorts)...),
+		ID:              int32(onu.ID),
+		SerialNumber:    onu.Sn(),
+		OperState:       onu.OperState.Current(),
+		InternalState:   onu.InternalState.Current(),
+		PonPortID:       int32(onu.PonPortID),
+		Unis:            append(convertBBsimUniPortsToProtoUniPorts(onu.UniPorts), convertBBsimPotsPortsToProtoUniPorts(onu.PotsPorts)...),
+		LastStateChange: formatLastStateChange(onu),
+		UptimeSeconds:   uint64(onu.Uptime().Seconds()),
 	}
 	return &res, nil
 }
 
+// formatLastStateChange renders the last FSM transition timestamp of an ONU,
+// an empty string if the ONU never moved
+func formatLastStateChange(onu *devices.Onu) string {
+	t := onu.LastStateChangeTime()
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
 // ShutdownONU sends DyingGasp indication for specified ONUs and mark ONUs as disabled.
 func (s BBSimServer) ShutdownONU(ctx context.Context, req *bbsim.ONURequest) (*bbsim.Response, error) {
 	// NOTE this method is now sending a Dying Gasp and then disabling the device (operState: down, adminState: up),
@@ -141,6 +161,111 @@ func (s BBSimServer) ShutdownAllONUs(context.Context, *bbsim.Empty) (*bbsim.Resp
 	return res, nil
 }
 
+// SimulateOnuPowerLoss simulates an ONU unexpectedly losing power: a dying-gasp
+// indication is sent and the ONU goes down, optionally after a delay. The ONU
+// can be recovered with PoweronONU
+func (s BBSimServer) SimulateOnuPowerLoss(ctx context.Context, req *bbsim.PowerLossRequest) (*bbsim.Response, error) {
+	logger.WithFields(log.Fields{
+		"OnuSn":   req.SerialNumber,
+		"DelayMs": req.DelayMs,
+	}).Infof("Received request to simulate ONU power loss")
+
+	res := &bbsim.Response{}
+	olt := devices.GetOLT()
+
+	onu, err := olt.FindOnuBySn(req.SerialNumber)
+	if err != nil {
+		res.StatusCode = int32(codes.NotFound)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	delay := time.Duration(req.DelayMs) * time.Millisecond
+
+	if delay > 0 {
+		// with a delay the power loss happens in the background and the
+		// request is only acknowledged
+		go func() {
+			_ = onu.HandlePowerLossONU(delay)
+		}()
+		res.StatusCode = int32(codes.OK)
+		res.Message = fmt.Sprintf("Request accepted, ONU %s will lose power in %s", onu.Sn(), delay)
+		return res, nil
+	}
+
+	if err := onu.HandlePowerLossONU(0); err != nil {
+		res.StatusCode = int32(codes.FailedPrecondition)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("ONU %s lost power.", onu.Sn())
+
+	return res, nil
+}
+
+// RebootONU simulates a per-ONU reboot: the ONU goes down, stays down for the
+// requested delay and then powers back on by itself, re-running discovery and
+// the OMCI bring-up. Unlike ShutdownONU the ONU comes back without a PoweronONU
+func (s BBSimServer) RebootONU(ctx context.Context, req *bbsim.OnuRebootRequest) (*bbsim.Response, error) {
+	logger.WithFields(log.Fields{
+		"OnuSn":   req.SerialNumber,
+		"DelayMs": req.DelayMs,
+	}).Infof("Received request to reboot ONU")
+
+	res := &bbsim.Response{}
+	olt := devices.GetOLT()
+
+	onu, err := olt.FindOnuBySn(req.SerialNumber)
+	if err != nil {
+		res.StatusCode = int32(codes.NotFound)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	delay := time.Duration(req.DelayMs) * time.Millisecond
+
+	if err := onu.RebootOnu(delay); err != nil {
+		res.StatusCode = int32(codes.FailedPrecondition)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("ONU %s is rebooting, it will power back on in %s.", onu.Sn(), delay)
+
+	return res, nil
+}
+
+// CancelONUReboot aborts a pending ONU reboot, leaving the ONU down
+func (s BBSimServer) CancelONUReboot(ctx context.Context, req *bbsim.ONURequest) (*bbsim.Response, error) {
+	logger.WithFields(log.Fields{
+		"OnuSn": req.SerialNumber,
+	}).Infof("Received request to cancel ONU reboot")
+
+	res := &bbsim.Response{}
+	olt := devices.GetOLT()
+
+	onu, err := olt.FindOnuBySn(req.SerialNumber)
+	if err != nil {
+		res.StatusCode = int32(codes.NotFound)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	if err := onu.CancelOnuReboot(); err != nil {
+		res.StatusCode = int32(codes.FailedPrecondition)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("Reboot of ONU %s canceled, the ONU stays down.", onu.Sn())
+
+	return res, nil
+}
+
 // PoweronONU simulates ONU power on and start sending discovery indications to VOLTHA
 func (s BBSimServer) PoweronONU(ctx context.Context, req *bbsim.ONURequest) (*bbsim.Response, error) {
 	logger.WithFields(log.Fields{
@@ -228,6 +353,72 @@ func (s BBSimServer) PoweronAllONUs(context.Context, *bbsim.Empty) (*bbsim.Respo
 	return res, nil
 }
 
+// ActivateONUs activates a batch of ONUs in one call, either an explicit list
+// of serial numbers or every ONU on a PON port, with an optional pacing delay
+// between activations. Each ONU is reported individually so a script can see
+// which ones failed without re-querying the simulator
+func (s BBSimServer) ActivateONUs(ctx context.Context, req *bbsim.BulkActivationRequest) (*bbsim.BulkActivationResponse, error) {
+	logger.WithFields(log.Fields{
+		"SerialNumbers": req.SerialNumbers,
+		"AllOnPonPort":  req.AllOnPonPort,
+		"PonPortId":     req.PonPortId,
+		"PacingMs":      req.PacingMs,
+	}).Infof("Received request to bulk activate ONUs")
+
+	res := &bbsim.BulkActivationResponse{
+		Results: []*bbsim.OnuActivationResult{},
+	}
+	olt := devices.GetOLT()
+
+	var onus []*devices.Onu
+	if req.AllOnPonPort {
+		pon, err := olt.GetPonById(req.PonPortId)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+		onus = pon.Onus
+	} else {
+		for _, sn := range req.SerialNumbers {
+			onu, err := olt.FindOnuBySn(sn)
+			if err != nil {
+				res.Results = append(res.Results, &bbsim.OnuActivationResult{
+					SerialNumber: sn,
+					Success:      false,
+					Message:      err.Error(),
+				})
+				continue
+			}
+			onus = append(onus, onu)
+		}
+	}
+
+	pacing := time.Duration(req.PacingMs) * time.Millisecond
+
+	for i, onu := range onus {
+		if i > 0 && pacing > 0 {
+			devices.GetClock().Sleep(pacing)
+		}
+
+		result := &bbsim.OnuActivationResult{
+			SerialNumber: onu.Sn(),
+			Success:      true,
+		}
+		if pon, err := olt.GetPonById(onu.PonPortID); err != nil {
+			result.Success = false
+			result.Message = err.Error()
+		} else if pon.InternalState.Current() != "enabled" {
+			result.Success = false
+			result.Message = fmt.Sprintf("PON port %d not enabled", onu.PonPortID)
+		} else if _, err := handlePoweronONU(onu); err != nil {
+			result.Success = false
+			result.Message = err.Error()
+		}
+		res.Results = append(res.Results, result)
+	}
+
+	return res, nil
+}
+
 func (s BBSimServer) ChangeIgmpState(ctx context.Context, req *bbsim.IgmpRequest) (*bbsim.Response, error) {
 
 	// NOTE this API will change the IGMP state for all UNIs on the requested ONU
@@ -620,6 +811,22 @@ func (s BBSimServer) GetOnuTrafficSchedulers(ctx context.Context, req *bbsim.ONU
 	}
 }
 
+// GetOnuTrafficSchedulersById returns the traffic schedulers installed on
+// the ONU identified by intf/onu id, erroring if the ONU is unknown or no
+// schedulers are installed
+func (s BBSimServer) GetOnuTrafficSchedulersById(ctx context.Context, req *bbsim.OnuIdRequest) (*bbsim.ONUTrafficSchedulers, error) {
+	olt := devices.GetOLT()
+	ts := bbsim.ONUTrafficSchedulers{}
+
+	trafficSchedulers, err := olt.GetTrafficSchedulers(req.PonPortId, req.OnuId)
+	if err != nil {
+		return &ts, status.Error(codes.NotFound, err.Error())
+	}
+
+	ts.TraffSchedulers = trafficSchedulers
+	return &ts, nil
+}
+
 func handlePoweronONU(onu *devices.Onu) (*bbsim.Response, error) {
 	res := &bbsim.Response{}
 
@@ -690,3 +897,334 @@ func (s BBSimServer) InvalidateMds(ctx context.Context, req *bbsim.ONURequest) (
 
 	return res, nil
 }
+
+// DisableOnuRediscovery suppresses the automatic re-discovery of the ONU,
+// after a delete or deactivate the ONU stays down until explicitly re-activated
+func (s BBSimServer) DisableOnuRediscovery(ctx context.Context, req *bbsim.ONURequest) (*bbsim.Response, error) {
+	return setOnuRediscovery(req.SerialNumber, true)
+}
+
+// EnableOnuRediscovery resumes the automatic re-discovery of the ONU
+func (s BBSimServer) EnableOnuRediscovery(ctx context.Context, req *bbsim.ONURequest) (*bbsim.Response, error) {
+	return setOnuRediscovery(req.SerialNumber, false)
+}
+
+func setOnuRediscovery(serialNumber string, suppress bool) (*bbsim.Response, error) {
+	logger.WithFields(log.Fields{
+		"OnuSn":    serialNumber,
+		"Suppress": suppress,
+	}).Infof("Received request to change the automatic re-discovery of the ONU")
+
+	res := &bbsim.Response{}
+	olt := devices.GetOLT()
+
+	onu, err := olt.FindOnuBySn(serialNumber)
+	if err != nil {
+		res.StatusCode = int32(codes.NotFound)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	onu.SuppressRediscovery = suppress
+
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("Automatic re-discovery suppression of ONU %s set to %t.", onu.Sn(), suppress)
+
+	return res, nil
+}
+
+// SetOnuOmciFault configures the ONU to drop and/or delay OMCI messages of
+// the requested type, so adapter resilience to OMCI anomalies can be tested
+func (s BBSimServer) SetOnuOmciFault(ctx context.Context, req *bbsim.OmciFaultRequest) (*bbsim.Response, error) {
+	logger.WithFields(log.Fields{
+		"OnuSn":       req.SerialNumber,
+		"OmciMsgType": req.OmciMsgType,
+		"Drop":        req.Drop,
+		"DelayMs":     req.DelayMs,
+	}).Infof("Received request to inject an OMCI fault on the ONU")
+
+	res := &bbsim.Response{}
+	olt := devices.GetOLT()
+
+	onu, err := olt.FindOnuBySn(req.SerialNumber)
+	if err != nil {
+		res.StatusCode = int32(codes.NotFound)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	if err := onu.SetOmciFault(req.OmciMsgType, req.Drop, req.DelayMs); err != nil {
+		res.StatusCode = int32(codes.InvalidArgument)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("OMCI fault on %s messages injected on ONU %s.", req.OmciMsgType, onu.Sn())
+
+	return res, nil
+}
+
+// ClearOnuOmciFaults removes every OMCI fault injected on the ONU
+func (s BBSimServer) ClearOnuOmciFaults(ctx context.Context, req *bbsim.ONURequest) (*bbsim.Response, error) {
+	logger.WithFields(log.Fields{
+		"OnuSn": req.SerialNumber,
+	}).Infof("Received request to clear the OMCI faults of the ONU")
+
+	res := &bbsim.Response{}
+	olt := devices.GetOLT()
+
+	onu, err := olt.FindOnuBySn(req.SerialNumber)
+	if err != nil {
+		res.StatusCode = int32(codes.NotFound)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	onu.ClearOmciFaults()
+
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("OMCI faults cleared on ONU %s.", onu.Sn())
+
+	return res, nil
+}
+
+// SetOnuOmciResponseLatency sets the latency the ONU applies before every
+// OMCI response, so part of the fleet can simulate slow management
+func (s BBSimServer) SetOnuOmciResponseLatency(ctx context.Context, req *bbsim.OnuLatencyRequest) (*bbsim.Response, error) {
+	logger.WithFields(log.Fields{
+		"OnuSn":     req.SerialNumber,
+		"LatencyMs": req.LatencyMs,
+	}).Infof("Received request to set the OMCI response latency of the ONU")
+
+	res := &bbsim.Response{}
+	olt := devices.GetOLT()
+
+	onu, err := olt.FindOnuBySn(req.SerialNumber)
+	if err != nil {
+		res.StatusCode = int32(codes.NotFound)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	onu.OmciResponseLatencyMs = req.LatencyMs
+
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("OMCI response latency of ONU %s set to %dms.", onu.Sn(), req.LatencyMs)
+
+	return res, nil
+}
+
+// SetOnuDistance changes the simulated fiber distance of an ONU, as if the
+// subscriber had been physically moved, and re-ranges it against the PON's
+// optical budget
+func (s BBSimServer) SetOnuDistance(ctx context.Context, req *bbsim.OnuDistanceRequest) (*bbsim.Response, error) {
+	logger.WithFields(log.Fields{
+		"OnuSn":      req.SerialNumber,
+		"DistanceKm": req.DistanceKm,
+	}).Infof("Received request to change the distance of the ONU")
+
+	res := &bbsim.Response{}
+	olt := devices.GetOLT()
+
+	onu, err := olt.FindOnuBySn(req.SerialNumber)
+	if err != nil {
+		res.StatusCode = int32(codes.NotFound)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	if err := onu.SetDistance(req.DistanceKm); err != nil {
+		res.StatusCode = int32(codes.FailedPrecondition)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("Distance of ONU %s set to %dkm.", onu.Sn(), req.DistanceKm)
+
+	return res, nil
+}
+
+// GetOnuOmciQueue reports the depth, capacity, drop count and decoded message
+// types of the OMCI requests pending on an ONU channel, to help diagnose
+// stuck OMCI sequences. It is read-only and safe to call concurrently
+func (s BBSimServer) GetOnuOmciQueue(ctx context.Context, req *bbsim.ONURequest) (*bbsim.OnuOmciQueue, error) {
+	olt := devices.GetOLT()
+
+	onu, err := olt.FindOnuBySn(req.SerialNumber)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+
+	queue := bbsim.OnuOmciQueue{
+		Depth:        int32(len(onu.Channel)),
+		Capacity:     int32(cap(onu.Channel)),
+		Drops:        atomic.LoadUint64(&onu.OmciChannelDrops),
+		MessageTypes: onu.PendingOmciTypes(),
+	}
+
+	return &queue, nil
+}
+
+// SetOnuMeSupport marks an OMCI managed entity class as supported or unsupported
+// on an ONU, so vendor-specific ME gaps can be reproduced at runtime
+func (s BBSimServer) SetOnuMeSupport(ctx context.Context, req *bbsim.OnuMeSupportRequest) (*bbsim.Response, error) {
+	logger.WithFields(log.Fields{
+		"OnuSn":     req.SerialNumber,
+		"MeClassId": req.MeClassId,
+		"Supported": req.Supported,
+	}).Infof("Received request to change ONU ME support")
+
+	res := &bbsim.Response{}
+
+	olt := devices.GetOLT()
+	onu, err := olt.FindOnuBySn(req.SerialNumber)
+	if err != nil {
+		res.StatusCode = int32(codes.NotFound)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	onu.SetMeSupported(me.ClassID(req.MeClassId), req.Supported)
+
+	res.StatusCode = int32(codes.OK)
+	if req.Supported {
+		res.Message = fmt.Sprintf("ME class %d marked as supported on ONU %s.", req.MeClassId, onu.Sn())
+	} else {
+		res.Message = fmt.Sprintf("ME class %d marked as unsupported on ONU %s.", req.MeClassId, onu.Sn())
+	}
+	return res, nil
+}
+
+// SetOnuAal5PmCounters injects AAL5 PM history counters on an ONU so that
+// OMCI Get and GetCurrentData reads return non-zero history
+func (s BBSimServer) SetOnuAal5PmCounters(ctx context.Context, req *bbsim.OnuAal5PmCountersRequest) (*bbsim.Response, error) {
+	logger.WithFields(log.Fields{
+		"OnuSn": req.SerialNumber,
+	}).Infof("Received request to inject AAL5 PM counters")
+
+	res := &bbsim.Response{}
+
+	olt := devices.GetOLT()
+	onu, err := olt.FindOnuBySn(req.SerialNumber)
+	if err != nil {
+		res.StatusCode = int32(codes.NotFound)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	onu.SetAal5PmCounters(omcilib.Aal5PmCounters{
+		SumOfInvalidCsFieldErrors:  req.SumOfInvalidCsFieldErrors,
+		CrcViolations:              req.CrcViolations,
+		ReassemblyTimerExpirations: req.ReassemblyTimerExpirations,
+		BufferOverflows:            req.BufferOverflows,
+		EncapProtocolErrors:        req.EncapProtocolErrors,
+	})
+
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("AAL5 PM counters injected on ONU %s.", onu.Sn())
+	return res, nil
+}
+
+// AddOnuService instantiates one of the configured services on an ONU UNI at
+// runtime, so subscriber add/move/change can be tested without recreating the OLT
+func (s BBSimServer) AddOnuService(ctx context.Context, req *bbsim.OnuServiceRequest) (*bbsim.Response, error) {
+	logger.WithFields(log.Fields{
+		"OnuSn":       req.OnuSerialNumber,
+		"UniId":       req.UniId,
+		"ServiceName": req.ServiceName,
+		"CTag":        req.CTag,
+		"STag":        req.STag,
+	}).Infof("Received request to add a service to the ONU")
+
+	res := &bbsim.Response{}
+	olt := devices.GetOLT()
+
+	onu, err := olt.FindOnuBySn(req.OnuSerialNumber)
+	if err != nil {
+		res.StatusCode = int32(codes.NotFound)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	uni, err := onu.FindUniById(req.UniId)
+	if err != nil {
+		res.StatusCode = int32(codes.NotFound)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	var sYaml *common.ServiceYaml
+	for i, service := range common.Services {
+		if service.Name == req.ServiceName {
+			sYaml = &common.Services[i]
+			break
+		}
+	}
+	if sYaml == nil {
+		res.StatusCode = int32(codes.NotFound)
+		res.Message = fmt.Sprintf("cannot-find-service-%s-in-the-configuration", req.ServiceName)
+		return res, status.Errorf(codes.NotFound, "cannot-find-service-%s-in-the-configuration", req.ServiceName)
+	}
+
+	// 0 picks the configured default for the service
+	cTag := int(req.CTag)
+	if cTag == 0 {
+		cTag = sYaml.CTag
+	}
+	sTag := int(req.STag)
+	if sTag == 0 {
+		sTag = sYaml.STag
+	}
+
+	service, err := uni.AddService(*sYaml, cTag, sTag)
+	if err != nil {
+		res.StatusCode = int32(codes.InvalidArgument)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("Service %s added to ONU %s UNI %d with c-tag %d and s-tag %d.",
+		service.Name, onu.Sn(), uni.ID, service.CTag, service.STag)
+
+	return res, nil
+}
+
+// RemoveOnuService removes a service from an ONU UNI at runtime
+func (s BBSimServer) RemoveOnuService(ctx context.Context, req *bbsim.OnuServiceRequest) (*bbsim.Response, error) {
+	logger.WithFields(log.Fields{
+		"OnuSn":       req.OnuSerialNumber,
+		"UniId":       req.UniId,
+		"ServiceName": req.ServiceName,
+	}).Infof("Received request to remove a service from the ONU")
+
+	res := &bbsim.Response{}
+	olt := devices.GetOLT()
+
+	onu, err := olt.FindOnuBySn(req.OnuSerialNumber)
+	if err != nil {
+		res.StatusCode = int32(codes.NotFound)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	uni, err := onu.FindUniById(req.UniId)
+	if err != nil {
+		res.StatusCode = int32(codes.NotFound)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	if err := uni.RemoveService(req.ServiceName); err != nil {
+		res.StatusCode = int32(codes.NotFound)
+		res.Message = err.Error()
+		return res, err
+	}
+
+	res.StatusCode = int32(codes.OK)
+	res.Message = fmt.Sprintf("Service %s removed from ONU %s UNI %d.", req.ServiceName, onu.Sn(), uni.ID)
+
+	return res, nil
+}
@@ -45,6 +45,7 @@ func convertBBSimServiceToProtoService(s *devices.Service) *bbsim.Service {
 		ConfigureMacAddress: s.ConfigureMacAddress,
 		EnableMacLearning:   s.EnableMacLearning,
 		GemPort:             int32(s.GemPort),
+		AssignedIp:          s.AssignedIp,
 		EapolState:          s.EapolState.Current(),
 		DhcpState:           s.DHCPState.Current(),
 		IGMPState:           s.IGMPState.Current(),
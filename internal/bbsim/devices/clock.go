@@ -0,0 +1,52 @@
+/*
+ * Copyright 2018-2023 Open Networking Foundation (ONF) and the ONF Contributors
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+ * http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package devices
+
+import "time"
+
+// Clock abstracts wall-clock access so that time-based behavior (reboot
+// delays, stats intervals, signatures) can be made deterministic in tests
+type Clock interface {
+	Now() time.Time
+	Sleep(d time.Duration)
+	After(d time.Duration) <-chan time.Time
+}
+
+// realClock delegates to the time package
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) Sleep(d time.Duration)                  { time.Sleep(d) }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// deviceClock is the clock used throughout the package, defaulting to the
+// real one
+var deviceClock Clock = realClock{}
+
+// SetClock replaces the package clock and returns the previous one so
+// tests can restore it
+func SetClock(c Clock) Clock {
+	previous := deviceClock
+	deviceClock = c
+	return previous
+}
+
+// GetClock returns the package clock so other packages can keep their
+// time-based behavior consistent with the devices
+func GetClock() Clock {
+	return deviceClock
+}
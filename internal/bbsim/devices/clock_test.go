@@ -0,0 +1,71 @@
+/*
+ * Copyright 2018-2023 Open Networking Foundation (ONF) and the ONF Contributors
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+ * http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package devices
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/opencord/voltha-protos/v5/go/openolt"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock is a Clock whose time only moves when advanced explicitly,
+// sleeps return immediately
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Sleep(d time.Duration) { c.now = c.now.Add(d) }
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.now = c.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+func (c *fakeClock) advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func TestFakeClockHeartbeatFailure(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1000000, 0)}
+	previous := SetClock(clock)
+	defer SetClock(previous)
+
+	olt := OltDevice{
+		ID:        0,
+		signature: 1234,
+	}
+
+	// without failure injection the heartbeat returns the signature
+	res, err := olt.HeartbeatCheck(context.Background(), new(openolt.Empty))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1234), res.HeartbeatSignature)
+
+	// the heartbeat fails for the injected duration without real sleeps
+	olt.InjectHeartbeatFailure(10 * time.Second)
+	_, err = olt.HeartbeatCheck(context.Background(), new(openolt.Empty))
+	assert.Error(t, err)
+
+	clock.advance(11 * time.Second)
+	res, err = olt.HeartbeatCheck(context.Background(), new(openolt.Empty))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1234), res.HeartbeatSignature)
+}
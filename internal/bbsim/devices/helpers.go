@@ -18,7 +18,6 @@ package devices
 
 import (
 	"math/rand"
-	"time"
 
 	"github.com/looplab/fsm"
 	"github.com/opencord/bbsim/internal/common"
@@ -69,20 +68,42 @@ func getOperStateFSM(cb fsm.Callback) *fsm.FSM {
 }
 
 func publishEvent(eventType string, intfID int32, onuID int32, onuSerial string) {
+	currentTime := deviceClock.Now()
+
+	event := common.Event{
+		EventType: eventType,
+		OltID:     olt.ID,
+		IntfID:    intfID,
+		OnuID:     onuID,
+		OnuSerial: onuSerial,
+		Timestamp: currentTime.Format("2006-01-02 15:04:05.000000000"),
+		EpochTime: currentTime.UnixNano() / 1000000,
+	}
 	if olt.PublishEvents {
-		currentTime := time.Now()
-
-		event := common.Event{
-			EventType: eventType,
-			OltID:     olt.ID,
-			IntfID:    intfID,
-			OnuID:     onuID,
-			OnuSerial: onuSerial,
-			Timestamp: currentTime.Format("2006-01-02 15:04:05.000000000"),
-			EpochTime: currentTime.UnixNano() / 1000000,
-		}
-		olt.EventChannel <- event
+		olt.sendEvent(event)
 	}
+	olt.notifyEventSubscribers(event)
+}
+
+// publishSignatureChangeEvent notifies event consumers that the heartbeat
+// signature was regenerated, which happens when the OLT reboots
+func publishSignatureChangeEvent(oldSignature uint32, newSignature uint32) {
+	currentTime := deviceClock.Now()
+
+	event := common.Event{
+		EventType:    "OLT-heartbeat-signature-changed",
+		OltID:        olt.ID,
+		IntfID:       -1,
+		OnuID:        -1,
+		OldSignature: oldSignature,
+		NewSignature: newSignature,
+		Timestamp:    currentTime.Format("2006-01-02 15:04:05.000000000"),
+		EpochTime:    currentTime.UnixNano() / 1000000,
+	}
+	if olt.PublishEvents {
+		olt.sendEvent(event)
+	}
+	olt.notifyEventSubscribers(event)
 }
 
 func getPortStats(packetCount uint64, incrementStat bool) (*openolt.PortStatistics, uint64) {
@@ -107,7 +128,37 @@ func getPortStats(packetCount uint64, incrementStat bool) (*openolt.PortStatisti
 		TxErrorPackets: 0,
 		RxCrcErrors:    0,
 		BipErrors:      0,
-		Timestamp:      uint32(time.Now().Unix()),
+		Timestamp:      uint32(deviceClock.Now().Unix()),
+	}
+
+	return portStats, packetCount
+}
+
+// generatePortStats synthesizes continuously increasing counters for a port
+// based on its line rate (in Mbps) and the fraction of active traffic sources,
+// used as an alternative to replaying a canned stats file
+func generatePortStats(packetCount uint64, speedMbps uint32, intervalSeconds int, activeFraction float64) (*openolt.PortStatistics, uint64) {
+	// assume an average packet size of 1500 bytes at a utilization
+	// proportional to the active fraction of the line rate
+	bytes := uint64(float64(speedMbps) / 8 * 1000000 * float64(intervalSeconds) * activeFraction)
+	packetCount = packetCount + bytes/1500
+
+	portStats := &openolt.PortStatistics{
+		RxBytes:        packetCount * 1500,
+		RxPackets:      packetCount,
+		RxUcastPackets: packetCount * 40 / 100,
+		RxMcastPackets: packetCount * 30 / 100,
+		RxBcastPackets: packetCount * 30 / 100,
+		RxErrorPackets: 0,
+		TxBytes:        packetCount * 1500,
+		TxPackets:      packetCount,
+		TxUcastPackets: packetCount * 40 / 100,
+		TxMcastPackets: packetCount * 30 / 100,
+		TxBcastPackets: packetCount * 30 / 100,
+		TxErrorPackets: 0,
+		RxCrcErrors:    0,
+		BipErrors:      0,
+		Timestamp:      uint32(deviceClock.Now().Unix()),
 	}
 
 	return portStats, packetCount
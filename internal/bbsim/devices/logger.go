@@ -0,0 +1,43 @@
+/*
+ * Copyright 2018-2023 Open Networking Foundation (ONF) and the ONF Contributors
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+ * http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package devices
+
+import (
+	"github.com/opencord/bbsim/internal/common"
+	log "github.com/sirupsen/logrus"
+)
+
+// deviceLogger is a dedicated logger for the device package so that OLT/ONU
+// events can be formatted independently of the rest of BBSim, e.g. as JSON
+// for ingestion into a structured log store
+var deviceLogger = log.New()
+
+func init() {
+	deviceLogger.SetOutput(log.StandardLogger().Out)
+}
+
+// ConfigureDeviceLogging applies the global log level and caller settings to
+// the device package logger and optionally switches it to the logrus JSON
+// formatter. The default text format is left untouched when jsonFormat is false
+func ConfigureDeviceLogging(level string, caller bool, jsonFormat bool) {
+	common.SetLogLevel(deviceLogger, level, caller)
+	if jsonFormat {
+		deviceLogger.SetFormatter(&log.JSONFormatter{
+			TimestampFormat: "2006-01-02T15:04:05.999999999Z07:00",
+		})
+	}
+}
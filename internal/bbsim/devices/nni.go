@@ -18,14 +18,17 @@ package devices
 
 import (
 	"encoding/hex"
+	"sync"
+
 	"github.com/google/gopacket"
 	"github.com/looplab/fsm"
 	"github.com/opencord/bbsim/internal/bbsim/packetHandlers"
+	"github.com/opencord/bbsim/internal/common"
 	"github.com/opencord/voltha-protos/v5/go/openolt"
 	log "github.com/sirupsen/logrus"
 )
 
-var nniLogger = log.WithFields(log.Fields{"module": "NNI"})
+var nniLogger = deviceLogger.WithFields(log.Fields{"module": "NNI"})
 
 type NniPort struct {
 	// BBSIM Internals
@@ -35,17 +38,23 @@ type NniPort struct {
 	// PON Attributes
 	OperState   *fsm.FSM
 	Type        string
+	Speed       uint32 // reported speed of the port in Mbps
 	PacketCount uint64 // dummy value for the stats
+
+	// link flap simulation, see OltDevice.FlapNni
+	flapLock   sync.Mutex
+	flapCancel chan struct{}
 }
 
-func CreateNNI(olt *OltDevice) (NniPort, error) {
-	nniPort := NniPort{
+func CreateNNI(olt *OltDevice, speed uint32) (*NniPort, error) {
+	nniPort := &NniPort{
 		ID: uint32(0),
 		OperState: getOperStateFSM(func(e *fsm.Event) {
 			oltLogger.Debugf("Changing NNI OperState from %s to %s", e.Src, e.Dst)
 		}),
-		Type: "nni",
-		Olt:  olt,
+		Type:  "nni",
+		Speed: speed,
+		Olt:   olt,
 	}
 
 	return nniPort, nil
@@ -54,10 +63,11 @@ func CreateNNI(olt *OltDevice) (NniPort, error) {
 // handleNniPacket will send a packet to a fake DHCP server implementation
 func (n *NniPort) handleNniPacket(packet gopacket.Packet) error {
 	isDhcp := packetHandlers.IsDhcpPacket(packet)
+	isDhcpv6 := n.Olt.Dhcpv6Enabled && packetHandlers.IsDhcpv6Packet(packet)
 	isLldp := packetHandlers.IsLldpPacket(packet)
 	isIcmp := packetHandlers.IsIcmpPacket(packet)
 
-	if !isDhcp && !isLldp && !isIcmp {
+	if !isDhcp && !isDhcpv6 && !isLldp && !isIcmp {
 		nniLogger.WithFields(log.Fields{
 			"packet": packet,
 		}).Debug("Dropping NNI packet as it's not DHCP")
@@ -66,6 +76,19 @@ func (n *NniPort) handleNniPacket(packet gopacket.Packet) error {
 
 	if isDhcp {
 
+		// when a trap VID is configured only DHCP packets tagged with that
+		// VLAN are trapped toward the DHCP server, the others are passed through
+		if trapVid := n.Olt.NniDhcpTrapVid; trapVid != 0 {
+			vid, err := packetHandlers.GetVlanTag(packet)
+			if err != nil || int(vid) != trapVid {
+				nniLogger.WithFields(log.Fields{
+					"TrapVid":   trapVid,
+					"PacketVid": vid,
+				}).Debug("Passing through DHCP packet not tagged with the trap VID")
+				return nil
+			}
+		}
+
 		// get a response packet from the DHCP server
 		pkt, err := n.Olt.dhcpServer.HandleServerPacket(packet)
 		if err != nil {
@@ -89,6 +112,45 @@ func (n *NniPort) handleNniPacket(packet gopacket.Packet) error {
 			}).Errorf("Fail to send PktInd indication: %v", err)
 			return err
 		}
+		common.IncrementPktIndCount()
+	} else if isDhcpv6 {
+
+		// the trap VID gates DHCPv6 packets exactly as it does DHCPv4 ones
+		if trapVid := n.Olt.NniDhcpTrapVid; trapVid != 0 {
+			vid, err := packetHandlers.GetVlanTag(packet)
+			if err != nil || int(vid) != trapVid {
+				nniLogger.WithFields(log.Fields{
+					"TrapVid":   trapVid,
+					"PacketVid": vid,
+				}).Debug("Passing through DHCPv6 packet not tagged with the trap VID")
+				return nil
+			}
+		}
+
+		// get a response packet from the DHCPv6 server
+		pkt, err := n.Olt.dhcpv6Server.HandleServerPacket(packet)
+		if err != nil {
+			nniLogger.WithFields(log.Fields{
+				"SourcePkt": hex.EncodeToString(packet.Data()),
+				"Err":       err,
+			}).Error("DHCPv6 Server can't handle packet")
+			return err
+		}
+
+		// send packetIndication to VOLTHA
+		data := &openolt.Indication_PktInd{PktInd: &openolt.PacketIndication{
+			IntfType: "nni",
+			IntfId:   n.ID,
+			Pkt:      pkt.Data()}}
+		if err := n.Olt.OpenoltStream.Send(&openolt.Indication{Data: data}); err != nil {
+			oltLogger.WithFields(log.Fields{
+				"IntfType": data.PktInd.IntfType,
+				"IntfId":   n.ID,
+				"Pkt":      hex.EncodeToString(pkt.Data()),
+			}).Errorf("Fail to send PktInd indication: %v", err)
+			return err
+		}
+		common.IncrementPktIndCount()
 	} else if isLldp {
 		// TODO rework this when BBSim supports data-plane packets
 		nniLogger.Debug("Received LLDP Packet, ignoring it")
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"github.com/google/gopacket"
 	"github.com/google/gopacket/layers"
+	"github.com/opencord/bbsim/internal/bbsim/responders/dhcp"
 	"github.com/opencord/voltha-protos/v5/go/openolt"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -30,11 +31,12 @@ func TestCreateNNI(t *testing.T) {
 	olt := OltDevice{
 		ID: 0,
 	}
-	nni, err := CreateNNI(&olt)
+	nni, err := CreateNNI(&olt, 10000)
 
 	assert.Nil(t, err)
 	assert.Equal(t, "nni", nni.Type)
 	assert.Equal(t, uint32(0), nni.ID)
+	assert.Equal(t, uint32(10000), nni.Speed)
 	assert.Equal(t, "down", nni.OperState.Current())
 }
 
@@ -84,6 +86,10 @@ func (s mockDhcpServer) HandleServerPacket(pkt gopacket.Packet) (gopacket.Packet
 	return pkt, nil
 }
 
+func (s mockDhcpServer) GetLeases() []dhcp.DhcpLease {
+	return nil
+}
+
 func createTestDhcpPacket(t *testing.T) gopacket.Packet {
 	dhcp := &layers.DHCPv4{
 		Operation: layers.DHCPOpRequest,
@@ -18,11 +18,18 @@ package devices
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"math/rand"
 	"net"
+	"runtime"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
   "encoding/json"
@@ -48,12 +55,14 @@ import (
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
   "github.com/opencord/voltha-protos/v5/go/bossopenolt"
 )
 
-var oltLogger = log.WithFields(log.Fields{
+var oltLogger = deviceLogger.WithFields(log.Fields{
 	"module": "OLT",
 })
 
@@ -74,6 +83,9 @@ const (
 type OltDevice struct {
 	sync.Mutex
 	OltServer *grpc.Server
+	// standard gRPC health service, reports SERVING only while the OLT
+	// internal state is enabled so orchestration can probe readiness
+	healthServer *health.Server
 
 	// BBSIM Internals
 	ID                   int
@@ -88,12 +100,35 @@ type OltDevice struct {
 	InternalState        *fsm.FSM
 	channel              chan types.Message
 	dhcpServer           dhcp.DHCPServerIf
+	dhcpv6Server         dhcp.DHCPv6ServerIf
+	Dhcpv6Enabled        bool
 	Flows                sync.Map
 	Delay                int
 	ControlledActivation mode
 	EventChannel         chan common.Event
 	PublishEvents        bool
+	// channels registered by streaming API subscribers, each receives a
+	// copy of every published event
+	eventSubscribersLock sync.Mutex
+	eventSubscribers     map[chan common.Event]struct{}
+	// number of events dropped because EventChannel was full
+	droppedEvents uint64
+	// guards the port packet counters so that TakeStatsSnapshot sees a
+	// consistent view across all counter families
+	statsLock sync.Mutex
+	// BIP error counts injected per interface ID, reported in the port
+	// stats regardless of the latency flag
+	injectedBipLock   sync.Mutex
+	injectedBipErrors map[uint32]uint64
+	// serial numbers of the ONUs that were enabled when the OLT was
+	// disabled, used by ReenableOlt to restore them. Cleared on reboot.
+	activeOnusLock      sync.Mutex
+	activeBeforeDisable map[string]struct{}
 	PortStatsInterval    int
+	PortStatsSource      string
+	PonSpeed             uint32
+	OnuSerials           map[string]string
+	OnuVendors           map[string]string
 	PreviouslyConnected  bool
 
 	Pons []*PonPort
@@ -107,6 +142,13 @@ type OltDevice struct {
 
 	OpenoltStream openolt.Openolt_EnableIndicationServer
 	enablePerf    bool
+	// periodic port stats can be toggled independently of enablePerf,
+	// e.g. to capture KPIs during perf runs
+	enablePortStats bool
+	// order in which the olt, nni and pon indications are sent by Enable
+	// and optional delay between the steps, see parseStartupIndicationOrder
+	startupIndicationOrder []string
+	startupIndicationDelay time.Duration
 
 	// Allocated Resources
 	// this data are to verify that the openolt adapter does not duplicate resources
@@ -114,9 +156,65 @@ type OltDevice struct {
 	AllocIDs         map[uint32]map[uint32]map[uint32]map[int32]map[uint64]bool // map[ponPortId]map[OnuId]map[PortNo]map[AllocIds]map[FlowId]bool
 	GemPortIDsLock   sync.RWMutex
 	GemPortIDs       map[uint32]map[uint32]map[uint32]map[int32]map[uint64]bool // map[ponPortId]map[OnuId]map[PortNo]map[GemPortIDs]map[FlowId]bool
+	// whether a gem port was provisioned with AES encryption, keyed by
+	// PON id and gem port id. Filled from the traffic queues
+	gemPortEncryptionLock sync.RWMutex
+	gemPortEncryption     map[uint32]map[uint32]bool
+	// downstream multicast flows installed via FlowAdd, keyed by flow id.
+	// Used to replicate multicast traffic to the ONUs that joined the
+	// flow's group, see deliverMcastPacket
+	mcastFlowsLock sync.RWMutex
+	mcastFlows     map[uint64]*openolt.Flow
 	OmciResponseRate uint8
 	signature        uint32
+	// how new signatures are generated, see newSignature
+	signatureMode    string
+	signatureFixed   uint32
+	signatureCounter uint32
+	// while this is in the future HeartbeatCheck returns an error,
+	// used to simulate a flaky management channel
+	heartbeatFailUntil time.Time
+	// MAC-layer settings provisioned via the BOSS endpoints,
+	// guarded by bossMu as provisioning clients may run concurrently
+	bossMu  sync.RWMutex
+	bossMac bossMacConfig
+	// burst-mode parameters provisioned via the BOSS endpoints, also
+	// guarded by bossMu
+	bossBurst bossBurstConfig
+	// per-ONU BOSS state (VSSN, SLA entries, alloc-ids), also guarded by
+	// bossMu. Cleared by removeOnuState when the ONU is deleted so a
+	// re-added ONU does not inherit stale provisioning
+	bossOnus map[uint32]*bossOnuState
+	// FEC error-permit threshold provisioned via SetErrorPermit, also
+	// drives the rate of corrected FEC codewords counted by the ONUs
+	errorPermit int32
+	// FEC decode mode provisioned via SetFecDecMode (1 enables decoding,
+	// 0 disables it). With decoding off the errors the error-permit
+	// threshold would have corrected surface as uncorrectable codewords
+	fecDecMode int32
+	// upstream OMCI bytes pending retrieval via GetUsOmciData, filled as
+	// downstream OMCI writes are acknowledged. Guarded by usOmciLock.
+	usOmciLock  sync.Mutex
+	usOmciQueue []int32
+	// latency flow armed via SetLatencyFlow, guarded by bossMu. The simulated
+	// measurement is fixed when the flow is armed so repeated reads return a
+	// stable value until the flow is cleared
+	latencyFlow    *bossopenolt.LatencyFlow
+	latencyMeasure int32
+	// offset added to the clock when reporting the time-of-day, advanced
+	// by the AdvanceTod test helper. Accessed atomically.
+	todOffsetNs int64
+	// packets received on OnuPacketOut/UplinkPacketOut that did not decode
+	// as Ethernet frames and were dropped. Accessed atomically.
+	MalformedPackets uint64
+	// how long processOltMessages may sit on pending messages before the
+	// watchdog reports the loop as stuck, 0 disables the watchdog
+	msgWatchdogInterval time.Duration
+	// when the indication loop last finished processing a message, as unix
+	// nanoseconds. Accessed atomically, read by the watchdog.
+	lastOltMsgProcessed int64
   OltStats         []openolt.PortStatistics
+  oltStatsIndex    int // index of the next OltStats entry to replay
 }
 
 var olt OltDevice
@@ -126,7 +224,30 @@ func GetOLT() *OltDevice {
 	return &olt
 }
 
-func CreateOLT(options common.GlobalConfig, services []common.ServiceYaml, isMock bool) *OltDevice {
+// parseStartupIndicationOrder validates a comma separated list of the "olt",
+// "nni" and "pon" startup steps, each of which has to appear exactly once
+func parseStartupIndicationOrder(order string) ([]string, error) {
+	steps := strings.Split(order, ",")
+	seen := make(map[string]bool)
+	for i, step := range steps {
+		steps[i] = strings.TrimSpace(step)
+		switch steps[i] {
+		case "olt", "nni", "pon":
+			if seen[steps[i]] {
+				return nil, fmt.Errorf("step %s listed twice", steps[i])
+			}
+			seen[steps[i]] = true
+		default:
+			return nil, fmt.Errorf("unknown step %s", steps[i])
+		}
+	}
+	if len(steps) != 3 {
+		return nil, fmt.Errorf("the olt, nni and pon steps must all be listed")
+	}
+	return steps, nil
+}
+
+func CreateOLT(options common.GlobalConfig, services []common.ServiceYaml, isMock bool) (*OltDevice, error) {
 	oltLogger.WithFields(log.Fields{
 		"ID":             options.Olt.ID,
 		"NumNni":         options.Olt.NniPorts,
@@ -138,6 +259,26 @@ func CreateOLT(options common.GlobalConfig, services []common.ServiceYaml, isMoc
 		"NniDhcpTrapVid": options.Olt.NniDhcpTrapVid,
 	}).Debug("CreateOLT")
 
+	// guard against topologies large enough to exhaust memory, a single
+	// fat-fingered config should not take down the host
+	totalOnus := options.Olt.PonPorts * options.Olt.OnusPonPort
+	if options.Olt.MaxOnus > 0 {
+		if totalOnus > options.Olt.MaxOnus {
+			oltLogger.WithFields(log.Fields{
+				"NumPon":       options.Olt.PonPorts,
+				"NumOnuPerPon": options.Olt.OnusPonPort,
+				"MaxOnus":      options.Olt.MaxOnus,
+			}).Error("Total number of ONUs exceeds the configured limit")
+			return nil, fmt.Errorf("total number of ONUs %d exceeds the configured limit of %d", totalOnus, options.Olt.MaxOnus)
+		}
+		if totalOnus*10 >= options.Olt.MaxOnus*8 {
+			oltLogger.WithFields(log.Fields{
+				"TotalOnus": totalOnus,
+				"MaxOnus":   options.Olt.MaxOnus,
+			}).Warn("Total number of ONUs is approaching the configured limit")
+		}
+	}
+
 	olt = OltDevice{
 		ID:           options.Olt.ID,
 		SerialNumber: fmt.Sprintf("ETRI_OLT_%d", options.Olt.ID),
@@ -155,22 +296,59 @@ func CreateOLT(options common.GlobalConfig, services []common.ServiceYaml, isMoc
 		Nnis:                []*NniPort{},
 		Delay:               options.BBSim.Delay,
 		enablePerf:          options.BBSim.EnablePerf,
+		enablePortStats:     options.BBSim.EnablePortStats,
 		PublishEvents:       options.BBSim.Events,
 		PortStatsInterval:   options.Olt.PortStatsInterval,
+		PortStatsSource:     options.Olt.PortStatsSource,
+		PonSpeed:            options.Olt.PonSpeed,
+		OnuSerials:          options.Olt.OnuSerials,
+		OnuVendors:          options.Olt.OnuVendors,
 		dhcpServer:          dhcp.NewDHCPServer(),
+		dhcpv6Server:        dhcp.NewDHCPv6Server(),
+		Dhcpv6Enabled:       options.Olt.Dhcpv6Enabled,
 		PreviouslyConnected: false,
 		AllocIDs:            make(map[uint32]map[uint32]map[uint32]map[int32]map[uint64]bool),
 		GemPortIDs:          make(map[uint32]map[uint32]map[uint32]map[int32]map[uint64]bool),
+		mcastFlows:          make(map[uint64]*openolt.Flow),
+		gemPortEncryption:   make(map[uint32]map[uint32]bool),
 		OmciResponseRate:    options.Olt.OmciResponseRate,
-		signature:           uint32(time.Now().Unix()),
+		signatureMode:       options.Olt.SignatureMode,
+		signatureFixed:      options.Olt.SignatureValue,
+		msgWatchdogInterval: time.Duration(options.Olt.MsgWatchdogIntervalMs) * time.Millisecond,
+		bossMac:             defaultBossMacConfig(),
+		bossBurst:           defaultBossBurstConfig(),
+		errorPermit:         defaultErrorPermit,
+		fecDecMode:          defaultFecDecMode,
+	}
+
+	switch options.Olt.SignatureMode {
+	case "", "unix-seconds", "random", "counter", "fixed":
+	default:
+		return nil, fmt.Errorf("invalid signature_mode %s", options.Olt.SignatureMode)
+	}
+	olt.signature = olt.newSignature()
+
+	olt.startupIndicationDelay = time.Duration(options.BBSim.StartupIndicationDelayMs) * time.Millisecond
+	if options.BBSim.StartupIndicationOrder != "" {
+		order, err := parseStartupIndicationOrder(options.BBSim.StartupIndicationOrder)
+		if err != nil {
+			return nil, fmt.Errorf("invalid startup_indication_order %s: %v", options.BBSim.StartupIndicationOrder, err)
+		}
+		olt.startupIndicationOrder = order
 	}
 
-	if val, ok := ControlledActivationModes[options.BBSim.ControlledActivation]; ok {
+	if options.BBSim.ControlledActivation == "" {
+		// no mode specified, run with the default activation flow
+		olt.ControlledActivation = Default
+	} else if val, ok := ControlledActivationModes[options.BBSim.ControlledActivation]; ok {
 		olt.ControlledActivation = val
 	} else {
-		// FIXME throw an error if the ControlledActivation is not valid
-		oltLogger.Warn("Unknown ControlledActivation Mode given, running in Default mode")
-		olt.ControlledActivation = Default
+		modes := make([]string, 0, len(ControlledActivationModes))
+		for m := range ControlledActivationModes {
+			modes = append(modes, m)
+		}
+		sort.Strings(modes)
+		return nil, fmt.Errorf("unknown ControlledActivation mode %s, valid modes are: %s", options.BBSim.ControlledActivation, strings.Join(modes, ", "))
 	}
 
 	// OLT State machine
@@ -187,28 +365,47 @@ func CreateOLT(options common.GlobalConfig, services []common.ServiceYaml, isMoc
 		fsm.Callbacks{
 			"enter_state": func(e *fsm.Event) {
 				oltLogger.Debugf("Changing OLT InternalState from %s to %s", e.Src, e.Dst)
+				olt.updateHealthStatus(e.Dst)
 			},
 			fmt.Sprintf("enter_%s", OltInternalStateInitialized): func(e *fsm.Event) { olt.InitOlt() },
 			fmt.Sprintf("enter_%s", OltInternalStateDeleted): func(e *fsm.Event) {
 				// remove all the resource allocations
 				olt.clearAllResources()
+				// a delete from the disabled state, or one explicitly forced
+				// by RestartOLTWithMode, is a hard reboot: the OLT comes back
+				// factory-fresh, so the BOSS configuration is reset to
+				// defaults. A soft reboot (delete from enabled) keeps it
+				hard := e.Src == OltInternalStateDisabled
+				if len(e.Args) > 0 {
+					if forced, ok := e.Args[0].(bool); ok && forced {
+						hard = true
+					}
+				}
+				if hard {
+					olt.resetBossConfig()
+				}
 			},
 		},
 	)
 
 	if !isMock {
 		// create NNI Port
-		nniPort, err := CreateNNI(&olt)
+		nniPort, err := CreateNNI(&olt, options.Olt.NniSpeed)
 		if err != nil {
-			oltLogger.Fatalf("Couldn't create NNI Port: %v", err)
+			return nil, fmt.Errorf("couldn't create NNI port: %v", err)
 		}
 
-		olt.Nnis = append(olt.Nnis, &nniPort)
+		olt.Nnis = append(olt.Nnis, nniPort)
 	}
 
-	// Create device and Services
-	nextCtag := map[string]int{}
-	nextStag := map[string]int{}
+	// ONUs are created by a pool of per-PON workers as the per-ONU setup
+	// dominates startup time on large topologies. Every worker gets its own
+	// tag allocator, seeded so the assignment matches a serial creation pass.
+	unisPerPon := olt.NumOnuPerPon * olt.NumUni
+	var onuWg sync.WaitGroup
+	workers := make(chan struct{}, runtime.NumCPU())
+
+	olt.Pons = make([]*PonPort, olt.NumPon)
 
 	// create PON ports
 	for i := 0; i < olt.NumPon; i++ {
@@ -217,7 +414,8 @@ func CreateOLT(options common.GlobalConfig, services []common.ServiceYaml, isMoc
 			oltLogger.WithFields(log.Fields{
 				"Err":    err,
 				"IntfId": i,
-			}).Fatal("cannot-get-pon-configuration")
+			}).Error("cannot-get-pon-configuration")
+			return nil, fmt.Errorf("cannot get configuration for PON port %d: %v", i, err)
 		}
 
 		tech, err := common.PonTechnologyFromString(ponConf.Technology)
@@ -225,7 +423,8 @@ func CreateOLT(options common.GlobalConfig, services []common.ServiceYaml, isMoc
 			oltLogger.WithFields(log.Fields{
 				"Err":    err,
 				"IntfId": i,
-			}).Fatal("unkown-pon-port-technology")
+			}).Error("unkown-pon-port-technology")
+			return nil, fmt.Errorf("unknown technology for PON port %d: %v", i, err)
 		}
 
 		// initialize the resource maps for every PON Ports
@@ -233,6 +432,10 @@ func CreateOLT(options common.GlobalConfig, services []common.ServiceYaml, isMoc
 		olt.GemPortIDs[uint32(i)] = make(map[uint32]map[uint32]map[int32]map[uint64]bool)
 
 		p := CreatePonPort(&olt, uint32(i), tech)
+		p.Latency = time.Duration(ponConf.LatencyMs) * time.Millisecond
+		p.Jitter = time.Duration(ponConf.JitterMs) * time.Millisecond
+		p.PacketLossRate = ponConf.PacketLossRate
+		p.DiscoveryFailureRate = ponConf.DiscoveryFailureRate
 
 		// create ONU devices
 		if (ponConf.OnuRange.EndId - ponConf.OnuRange.StartId + 1) < uint32(olt.NumOnuPerPon) {
@@ -241,22 +444,32 @@ func CreateOLT(options common.GlobalConfig, services []common.ServiceYaml, isMoc
 				"RangeSize":    ponConf.OnuRange.EndId - ponConf.OnuRange.StartId + 1,
 				"NumOnuPerPon": olt.NumOnuPerPon,
 				"IntfId":       i,
-			}).Fatal("onus-per-pon-bigger-than-resource-range-size")
+			}).Error("onus-per-pon-bigger-than-resource-range-size")
+			return nil, fmt.Errorf("onus-per-pon bigger than the ONU range size on PON port %d", i)
 		}
 
-		for j := 0; j < olt.NumOnuPerPon; j++ {
-			delay := time.Duration(olt.Delay*j) * time.Millisecond
-			o := CreateONU(&olt, p, uint32(j+1), delay, nextCtag, nextStag, isMock)
+		olt.Pons[i] = p
 
-			p.Onus = append(p.Onus, o)
-		}
-		olt.Pons = append(olt.Pons, p)
+		onuWg.Add(1)
+		workers <- struct{}{}
+		go func(p *PonPort, ponID int) {
+			defer onuWg.Done()
+			defer func() { <-workers }()
+
+			tags := NewTagAllocator(ponID, unisPerPon)
+			for j := 0; j < olt.NumOnuPerPon; j++ {
+				delay := time.Duration(olt.Delay*j) * time.Millisecond
+				o := CreateONU(&olt, p, uint32(j+1), delay, tags, isMock)
+
+				p.Onus = append(p.Onus, o)
+			}
+		}(p, i)
 	}
+	onuWg.Wait()
 
 	if !isMock {
 		if err := olt.InternalState.Event(OltInternalTxInitialize); err != nil {
-			log.Errorf("Error initializing OLT: %v", err)
-			return nil
+			return nil, fmt.Errorf("error initializing OLT: %v", err)
 		}
 	}
 
@@ -265,8 +478,11 @@ func CreateOLT(options common.GlobalConfig, services []common.ServiceYaml, isMoc
 		// Create a channel to write event messages
 		olt.EventChannel = make(chan common.Event, 100)
 	}
-  InitOltStats(&olt)
-	return &olt
+	// the stats file is only needed when we replay it
+	if olt.PortStatsSource != common.PortStatsSourceGenerated {
+		InitOltStats(&olt)
+	}
+	return &olt, nil
 }
 
 func InitOltStats(olt *OltDevice){
@@ -320,11 +536,13 @@ func InitOltStats(olt *OltDevice){
 
 func (o *OltDevice) InitOlt() {
 
-	if o.OltServer == nil {
-		o.OltServer, _ = o.StartOltServer()
-	} else {
-		oltLogger.Fatal("OLT server already running.")
+	if o.OltServer != nil {
+		// this can happen when re-initializing after a partial failure,
+		// replace the old server with a fresh one instead of fataling
+		oltLogger.Warn("OLT server already running, restarting it")
+		o.StopOltServer()
 	}
+	o.OltServer, _ = o.StartOltServer()
 
 	// create new channel for processOltMessages Go routine
 	o.channel = make(chan types.Message)
@@ -345,8 +563,30 @@ func (o *OltDevice) InitOlt() {
 }
 
 func (o *OltDevice) RestartOLT() error {
+	return o.restartOLT(false)
+}
+
+// RestartOLTWithMode reboots the OLT forcing the requested semantic: a "soft"
+// reboot keeps the BOSS provisioning, a "hard" one clears it as a
+// factory-fresh device would
+func (o *OltDevice) RestartOLTWithMode(mode string) error {
+	switch mode {
+	case "soft":
+		if !o.InternalState.Is(OltInternalStateEnabled) {
+			return fmt.Errorf("olt-must-be-enabled-for-a-soft-reboot-current-state-is-%s", o.InternalState.Current())
+		}
+		return o.restartOLT(false)
+	case "hard":
+		return o.restartOLT(true)
+	default:
+		return fmt.Errorf("unknown-reboot-mode-%s", mode)
+	}
+}
+
+func (o *OltDevice) restartOLT(forceHard bool) error {
 
 	o.PreviouslyConnected = false
+	o.forgetActiveOnus()
 
 	softReboot := false
 	rebootDelay := common.Config.Olt.OltRebootDelay
@@ -355,7 +595,7 @@ func (o *OltDevice) RestartOLT() error {
 		"oltId": o.ID,
 	}).Infof("Simulating OLT restart... (%ds)", rebootDelay)
 
-	if o.InternalState.Is(OltInternalStateEnabled) {
+	if !forceHard && o.InternalState.Is(OltInternalStateEnabled) {
 		oltLogger.WithFields(log.Fields{
 			"oltId": o.ID,
 		}).Info("This is an OLT soft reboot")
@@ -363,7 +603,7 @@ func (o *OltDevice) RestartOLT() error {
 	}
 
 	// transition internal state to deleted
-	if err := o.InternalState.Event(OltInternalTxDelete); err != nil {
+	if err := o.InternalState.Event(OltInternalTxDelete, forceHard); err != nil {
 		oltLogger.WithFields(log.Fields{
 			"oltId": o.ID,
 		}).Errorf("Error deleting OLT: %v", err)
@@ -409,7 +649,7 @@ func (o *OltDevice) RestartOLT() error {
 		}
 	}
 
-	time.Sleep(1 * time.Second) // we need to give the OLT the time to respond to all the pending gRPC request before stopping the server
+	deviceClock.Sleep(1 * time.Second) // we need to give the OLT the time to respond to all the pending gRPC request before stopping the server
 	o.StopOltServer()
 
 	// terminate the OLT's processOltMessages go routine
@@ -422,9 +662,22 @@ func (o *OltDevice) RestartOLT() error {
 	//Prevents Enable to progress before the reboot is completed (VOL-4616)
 	o.Lock()
 	o.enableContextCancel()
-	time.Sleep(time.Duration(rebootDelay) * time.Second)
+
+	// with the enable context canceled the per-ONU channels have no reader
+	// anymore and blocked senders (which go through Onu.sendMessage) have
+	// been released, drop whatever is still queued so the next enable
+	// starts clean
+	for _, pon := range o.Pons {
+		for _, onu := range pon.Onus {
+			onu.flushChannel()
+		}
+	}
+
+	deviceClock.Sleep(time.Duration(rebootDelay) * time.Second)
 	o.Unlock()
-	o.signature = uint32(time.Now().Unix())
+	oldSignature := o.signature
+	o.signature = o.newSignature()
+	publishSignatureChangeEvent(oldSignature, o.signature)
 
 	if err := o.InternalState.Event(OltInternalTxInitialize); err != nil {
 		oltLogger.WithFields(log.Fields{
@@ -438,6 +691,19 @@ func (o *OltDevice) RestartOLT() error {
 	return nil
 }
 
+// updateHealthStatus reflects the OLT internal state into the standard gRPC
+// health service: SERVING while enabled, NOT_SERVING otherwise
+func (o *OltDevice) updateHealthStatus(state string) {
+	if o.healthServer == nil {
+		return
+	}
+	status := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	if state == OltInternalStateEnabled {
+		status = grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	o.healthServer.SetServingStatus("", status)
+}
+
 // newOltServer launches a new grpc server for OpenOLT
 func (o *OltDevice) newOltServer() (*grpc.Server, error) {
 	address := common.Config.BBSim.OpenOltAddress
@@ -449,6 +715,9 @@ func (o *OltDevice) newOltServer() (*grpc.Server, error) {
 
 	openolt.RegisterOpenoltServer(grpcServer, o)
   bossopenolt.RegisterBossOpenoltServer(grpcServer, o)
+	o.healthServer = health.NewServer()
+	o.updateHealthStatus(o.InternalState.Current())
+	grpc_health_v1.RegisterHealthServer(grpcServer, o.healthServer)
 	reflection.Register(grpcServer)
 
 	go func() { _ = grpcServer.Serve(lis) }()
@@ -511,7 +780,7 @@ func (o *OltDevice) Enable(stream openolt.Openolt_EnableIndicationServer) error
 		oltLogger.Info("This is an OLT reboot or a reconcile")
 		o.enableContextCancel()
 		rebootFlag = true
-		time.Sleep(1 * time.Second)
+		deviceClock.Sleep(1 * time.Second)
 	}
 	o.enableContext, o.enableContextCancel = context.WithCancel(context.TODO())
 	o.Unlock()
@@ -525,38 +794,80 @@ func (o *OltDevice) Enable(stream openolt.Openolt_EnableIndicationServer) error
 	go o.processOltMessages(o.enableContext, stream, &wg)
 
 	// enable the OLT
-	oltMsg := types.Message{
-		Type: types.OltIndication,
-		Data: types.OltIndicationMessage{
-			OperState: types.UP,
-		},
+	sendOltIndication := func() {
+		oltMsg := types.Message{
+			Type: types.OltIndication,
+			Data: types.OltIndicationMessage{
+				OperState: types.UP,
+			},
+		}
+		o.channel <- oltMsg
 	}
-	o.channel <- oltMsg
 
 	// send NNI Port Indications
-	for _, nni := range o.Nnis {
-		msg := types.Message{
-			Type: types.NniIndication,
-			Data: types.NniIndicationMessage{
-				OperState: types.UP,
-				NniPortID: nni.ID,
-			},
+	sendNniIndications := func() {
+		for _, nni := range o.Nnis {
+			msg := types.Message{
+				Type: types.NniIndication,
+				Data: types.NniIndicationMessage{
+					OperState: types.UP,
+					NniPortID: nni.ID,
+				},
+			}
+			o.channel <- msg
 		}
-		o.channel <- msg
 	}
 
-	if rebootFlag {
+	// send PON Port indications
+	sendPonIndications := func() {
+		// 1. controlledActivation == Default: Send both PON and ONUs indications
+		// 2. controlledActivation == only-onu: that means only ONUs will be controlled activated, so auto send PON indications
+		// 3. controlledActivation == only-pon: that means only PONs will be controlled activated,
+		//    the ONUs are then discovered automatically once their PON port is enabled
+		// 4. controlledActivation == both: neither PON nor ONU indications are sent automatically
+		if o.ControlledActivation != Default && o.ControlledActivation != OnlyONU {
+			return
+		}
 		for _, pon := range o.Pons {
-			if pon.InternalState.Current() == "disabled" {
-				msg := types.Message{
-					Type: types.PonIndication,
-					Data: types.PonIndicationMessage{
-						OperState: types.UP,
-						PonPortID: pon.ID,
-					},
-				}
-				o.channel <- msg
+			// in only-pon and both modes the PON ports are activated by the controller,
+			// don't bring them back up automatically after a reboot
+			if rebootFlag && pon.InternalState.Current() != "disabled" {
+				continue
+			}
+			msg := types.Message{
+				Type: types.PonIndication,
+				Data: types.PonIndicationMessage{
+					OperState: types.UP,
+					PonPortID: pon.ID,
+				},
 			}
+			o.channel <- msg
+		}
+	}
+
+	// some controller versions are sensitive to the order in which the
+	// indications arrive, so it can be changed via startup_indication_order
+	// with an optional delay between the steps
+	steps := o.startupIndicationOrder
+	if len(steps) == 0 {
+		steps = []string{"olt", "nni", "pon"}
+	}
+	for i, step := range steps {
+		if i > 0 && o.startupIndicationDelay > 0 {
+			deviceClock.Sleep(o.startupIndicationDelay)
+		}
+		switch step {
+		case "olt":
+			sendOltIndication()
+		case "nni":
+			sendNniIndications()
+		case "pon":
+			sendPonIndications()
+		}
+	}
+
+	if rebootFlag {
+		for _, pon := range o.Pons {
 			// when the enableContext was canceled the ONUs stopped listening on the channel
 			for _, onu := range pon.Onus {
 				if o.ControlledActivation != OnlyONU {
@@ -570,27 +881,9 @@ func (o *OltDevice) Enable(stream openolt.Openolt_EnableIndicationServer) error
 				}
 			}
 		}
-	} else {
-
-		// 1. controlledActivation == Default: Send both PON and ONUs indications
-		// 2. controlledActivation == only-onu: that means only ONUs will be controlled activated, so auto send PON indications
-
-		if o.ControlledActivation == Default || o.ControlledActivation == OnlyONU {
-			// send PON Port indications
-			for _, pon := range o.Pons {
-				msg := types.Message{
-					Type: types.PonIndication,
-					Data: types.PonIndicationMessage{
-						OperState: types.UP,
-						PonPortID: pon.ID,
-					},
-				}
-				o.channel <- msg
-			}
-		}
 	}
 
-	if !o.enablePerf {
+	if o.enablePortStats {
 		// Start a go routine to send periodic port stats to openolt adapter
 		wg.Add(1)
 		go o.periodicPortStats(o.enableContext, &wg, stream)
@@ -607,11 +900,36 @@ func (o *OltDevice) Enable(stream openolt.Openolt_EnableIndicationServer) error
 func (o *OltDevice) periodicPortStats(ctx context.Context, wg *sync.WaitGroup, stream openolt.Openolt_EnableIndicationServer) {
 	//var portStats *openolt.PortStatistics
 
-  count := 0
 loop:
 	for {
 		select {
-		case <-time.After(time.Duration(o.PortStatsInterval) * time.Second):
+		case <-deviceClock.After(time.Duration(o.PortStatsInterval) * time.Second):
+			if o.PortStatsSource == common.PortStatsSourceGenerated {
+				// synthesize the counters instead of replaying the stats file,
+				// holding the stats lock so snapshots don't see partial updates
+				o.statsLock.Lock()
+				for _, port := range o.Nnis {
+					activeFraction := 0.0
+					if port.OperState.Current() == "up" {
+						activeFraction = 1.0
+					}
+					portStats, packetCount := generatePortStats(port.PacketCount, port.Speed, o.PortStatsInterval, activeFraction)
+					port.PacketCount = packetCount
+					o.sendPortStatsIndication(portStats, port.ID, port.Type, stream)
+				}
+
+				for _, port := range o.Pons {
+					activeFraction := 0.0
+					if port.OperState.Current() == "up" && port.NumOnu > 0 {
+						activeFraction = float64(port.GetNumOfActiveOnus()) / float64(port.NumOnu)
+					}
+					portStats, packetCount := generatePortStats(port.PacketCount, o.PonSpeed, o.PortStatsInterval, activeFraction)
+					port.PacketCount = packetCount
+					o.sendPortStatsIndication(portStats, port.ID, port.Type, stream)
+				}
+				o.statsLock.Unlock()
+				continue
+			}
 			// send NNI port stats
 //			for _, port := range o.Nnis {
 //				incrementStat := true
@@ -632,11 +950,20 @@ loop:
 //				portStats, port.PacketCount = getPortStats(port.PacketCount, incrementStat)
 //				o.sendPortStatsIndication(portStats, port.ID, port.Type, stream)
 //			}
-      sendStat := o.OltStats[count]
+      // guard against an empty stats file and against the slice being
+      // replaced under us, which would leave a stale index around
+      if len(o.OltStats) == 0 {
+        oltLogger.Debug("No OltStats loaded, skipping port stats")
+        continue
+      }
+      if o.oltStatsIndex >= len(o.OltStats) {
+        o.oltStatsIndex = 0
+      }
+      sendStat := o.OltStats[o.oltStatsIndex]
       o.send25GPortStatsIndication(&sendStat, stream)
-      count++
-      if len(o.OltStats)==count{
-        count =0
+      o.oltStatsIndex++
+      if len(o.OltStats)==o.oltStatsIndex{
+        o.oltStatsIndex =0
       }
 		case <-ctx.Done():
 			oltLogger.Debug("Stop sending port stats")
@@ -706,6 +1033,78 @@ func (o *OltDevice) GetPonById(id uint32) (*PonPort, error) {
 	return nil, fmt.Errorf("Cannot find PonPort with id %d in OLT %d", id, o.ID)
 }
 
+// FlapNni simulates uplink instability: the NNI goes operationally down and
+// comes back up by itself after downFor. Only the NNI FSM and indications
+// are touched, PON and ONU state are left alone
+func (o *OltDevice) FlapNni(nniId uint32, downFor time.Duration) error {
+	nni, err := o.getNniById(nniId)
+	if err != nil {
+		return err
+	}
+
+	nni.flapLock.Lock()
+	defer nni.flapLock.Unlock()
+	if nni.flapCancel != nil {
+		return fmt.Errorf("nni-%d-is-already-flapping", nniId)
+	}
+	cancel := make(chan struct{})
+	nni.flapCancel = cancel
+
+	oltLogger.WithFields(log.Fields{
+		"NniId":   nniId,
+		"DownFor": downFor,
+	}).Info("Flapping NNI port")
+
+	o.channel <- types.Message{
+		Type: types.NniIndication,
+		Data: types.NniIndicationMessage{
+			OperState: types.DOWN,
+			NniPortID: nniId,
+		},
+	}
+
+	go func() {
+		select {
+		case <-cancel:
+			// canceled, come back up right away
+		case <-deviceClock.After(downFor):
+		}
+
+		o.channel <- types.Message{
+			Type: types.NniIndication,
+			Data: types.NniIndicationMessage{
+				OperState: types.UP,
+				NniPortID: nniId,
+			},
+		}
+
+		nni.flapLock.Lock()
+		if nni.flapCancel == cancel {
+			nni.flapCancel = nil
+		}
+		nni.flapLock.Unlock()
+	}()
+
+	return nil
+}
+
+// CancelNniFlap aborts a flap in progress, bringing the NNI back up immediately
+func (o *OltDevice) CancelNniFlap(nniId uint32) error {
+	nni, err := o.getNniById(nniId)
+	if err != nil {
+		return err
+	}
+
+	nni.flapLock.Lock()
+	defer nni.flapLock.Unlock()
+	if nni.flapCancel == nil {
+		return fmt.Errorf("nni-%d-has-no-flap-in-progress", nniId)
+	}
+	close(nni.flapCancel)
+	nni.flapCancel = nil
+	return nil
+}
+
 func (o *OltDevice) getNniById(id uint32) (*NniPort, error) {
 	for _, nni := range o.Nnis {
 		if nni.ID == id {
@@ -715,6 +1114,97 @@ func (o *OltDevice) getNniById(id uint32) (*NniPort, error) {
 	return nil, fmt.Errorf("Cannot find NniPort with id %d in OLT %d", id, o.ID)
 }
 
+// PortStateSnapshot reports the current state of the state machines of a port,
+// InternalState is empty for ports that don't have an internal state machine
+type PortStateSnapshot struct {
+	ID            uint32
+	InternalState string
+	OperState     string
+}
+
+// OltStateSnapshot reports the current state of the OLT state machines and of
+// the state machines of all its ports, used for health checks and test
+// assertions on convergence
+type OltStateSnapshot struct {
+	ID            int
+	SerialNumber  string
+	InternalState string
+	OperState     string
+	Pons          []PortStateSnapshot
+	Nnis          []PortStateSnapshot
+}
+
+// GetStateSnapshot returns a structured snapshot of the OLT, PON and NNI state machines
+func (o *OltDevice) GetStateSnapshot() OltStateSnapshot {
+	snapshot := OltStateSnapshot{
+		ID:            o.ID,
+		SerialNumber:  o.SerialNumber,
+		InternalState: o.InternalState.Current(),
+		OperState:     o.OperState.Current(),
+		Pons:          []PortStateSnapshot{},
+		Nnis:          []PortStateSnapshot{},
+	}
+
+	for _, pon := range o.Pons {
+		snapshot.Pons = append(snapshot.Pons, PortStateSnapshot{
+			ID:            pon.ID,
+			InternalState: pon.InternalState.Current(),
+			OperState:     pon.OperState.Current(),
+		})
+	}
+
+	for _, nni := range o.Nnis {
+		snapshot.Nnis = append(snapshot.Nnis, PortStateSnapshot{
+			ID:        nni.ID,
+			OperState: nni.OperState.Current(),
+		})
+	}
+
+	return snapshot
+}
+
+// PonResourceUsage counts the distinct alloc-ids and gem-ports in use on a
+// PON port, combining the flow-assigned resources tracked by the OLT with
+// the OMCI-assigned ones tracked by the PON
+func (o *OltDevice) PonResourceUsage(pon *PonPort) (allocIds int, gemPorts int) {
+	usedAllocs := make(map[uint16]bool)
+	usedGems := make(map[uint16]bool)
+
+	o.AllocIDsLock.RLock()
+	for _, onuValues := range o.AllocIDs[pon.ID] {
+		for _, uniValues := range onuValues {
+			for allocId := range uniValues {
+				usedAllocs[uint16(allocId)] = true
+			}
+		}
+	}
+	o.AllocIDsLock.RUnlock()
+
+	o.GemPortIDsLock.RLock()
+	for _, onuValues := range o.GemPortIDs[pon.ID] {
+		for _, uniValues := range onuValues {
+			for gemId := range uniValues {
+				usedGems[uint16(gemId)] = true
+			}
+		}
+	}
+	o.GemPortIDsLock.RUnlock()
+
+	pon.allocatedAllocIdsLock.RLock()
+	for _, val := range pon.AllocatedAllocIds {
+		usedAllocs[val.AllocID] = true
+	}
+	pon.allocatedAllocIdsLock.RUnlock()
+
+	pon.allocatedGemPortsLock.RLock()
+	for gemId := range pon.AllocatedGemPorts {
+		usedGems[gemId] = true
+	}
+	pon.allocatedGemPortsLock.RUnlock()
+
+	return len(usedAllocs), len(usedGems)
+}
+
 func (o *OltDevice) sendAlarmIndication(alarmInd *openolt.AlarmIndication, stream openolt.Openolt_EnableIndicationServer) {
 	data := &openolt.Indication_AlarmInd{AlarmInd: alarmInd}
 	if err := stream.Send(&openolt.Indication{Data: data}); err != nil {
@@ -728,6 +1218,10 @@ func (o *OltDevice) sendAlarmIndication(alarmInd *openolt.AlarmIndication, strea
 }
 
 func (o *OltDevice) sendOltIndication(msg types.OltIndicationMessage, stream openolt.Openolt_EnableIndicationServer) {
+	if stream == nil {
+		oltLogger.Warn("Dropping Indication_OltInd: no openolt stream")
+		return
+	}
 	data := &openolt.Indication_OltInd{OltInd: &openolt.OltIndication{OperState: msg.OperState.String()}}
 	if err := stream.Send(&openolt.Indication{Data: data}); err != nil {
 		oltLogger.Errorf("Failed to send Indication_OltInd: %v", err)
@@ -740,6 +1234,10 @@ func (o *OltDevice) sendOltIndication(msg types.OltIndicationMessage, stream ope
 }
 
 func (o *OltDevice) sendNniIndication(msg types.NniIndicationMessage, stream openolt.Openolt_EnableIndicationServer) {
+	if stream == nil {
+		oltLogger.Warnf("Dropping Indication_IntfOperInd for NNI %d: no openolt stream", msg.NniPortID)
+		return
+	}
 	nni, _ := o.getNniById(msg.NniPortID)
 	if msg.OperState == types.UP {
 		if err := nni.OperState.Event("enable"); err != nil {
@@ -763,7 +1261,7 @@ func (o *OltDevice) sendNniIndication(msg types.NniIndicationMessage, stream ope
 		Type:      nni.Type,
 		IntfId:    nni.ID,
 		OperState: nni.OperState.Current(),
-		Speed:     o.NniSpeed,
+		Speed:     nni.Speed,
 	}}
 
 	if err := stream.Send(&openolt.Indication{Data: operData}); err != nil {
@@ -775,13 +1273,18 @@ func (o *OltDevice) sendNniIndication(msg types.NniIndicationMessage, stream ope
 		"Type":      nni.Type,
 		"IntfId":    nni.ID,
 		"OperState": nni.OperState.Current(),
-		"Speed":     o.NniSpeed,
+		"Speed":     nni.Speed,
 	}).Debug("Sent Indication_IntfOperInd for NNI")
 }
 
 func (o *OltDevice) sendPonIndication(ponPortID uint32) {
 
 	stream := o.OpenoltStream
+	if stream == nil {
+		// a PON event can race the Enable call that stores the stream
+		oltLogger.Warnf("Dropping Indication_IntfInd for PON %d: no openolt stream", ponPortID)
+		return
+	}
 	pon, _ := o.GetPonById(ponPortID)
 	// Send IntfIndication for PON port
 	discoverData := &openolt.Indication_IntfInd{IntfInd: &openolt.IntfIndication{
@@ -818,12 +1321,44 @@ func (o *OltDevice) sendPonIndication(ponPortID uint32) {
 	}).Debug("Sent Indication_IntfOperInd for PON")
 }
 
+// SetInjectedBipErrors forces the BIP error count reported in the port stats
+// of the given interface, a count of 0 clears the injection. The map is keyed
+// by the raw interface id (the PON or NNI id as provisioned), not the VOLTHA
+// port number, so the same injection also drives the FEC codeword counters
+// and the latency measurement
+func (o *OltDevice) SetInjectedBipErrors(intfId uint32, errors uint64) {
+	o.injectedBipLock.Lock()
+	defer o.injectedBipLock.Unlock()
+	if errors == 0 {
+		delete(o.injectedBipErrors, intfId)
+		return
+	}
+	if o.injectedBipErrors == nil {
+		o.injectedBipErrors = make(map[uint32]uint64)
+	}
+	o.injectedBipErrors[intfId] = errors
+}
+
+// injectedBipErrorsFor returns the injected BIP error count for the given
+// interface and whether an injection is active
+func (o *OltDevice) injectedBipErrorsFor(intfId uint32) (uint64, bool) {
+	o.injectedBipLock.Lock()
+	defer o.injectedBipLock.Unlock()
+	errors, ok := o.injectedBipErrors[intfId]
+	return errors, ok
+}
+
 func (o *OltDevice) sendPortStatsIndication(stats *openolt.PortStatistics, portID uint32, portType string, stream openolt.Openolt_EnableIndicationServer) {
 	if o.InternalState.Current() == OltInternalStateEnabled {
 		oltLogger.WithFields(log.Fields{
 			"Type":   portType,
 			"IntfId": portID,
 		}).Debug("Sending port stats")
+		// the injection map is keyed by the raw interface id, so look the
+		// override up before the port-no conversion
+		if errors, ok := o.injectedBipErrorsFor(portID); ok {
+			stats.BipErrors = errors
+		}
 		stats.IntfId = InterfaceIDToPortNo(portID, portType)
 		data := &openolt.Indication_PortStats{
 			PortStats: stats,
@@ -835,13 +1370,56 @@ func (o *OltDevice) sendPortStatsIndication(stats *openolt.PortStatistics, portI
 		}
 	}
 }
+// port number bases used by the canned stats file to attribute an entry to a port
+const (
+  replayNniPortBase = 0x1 << 24 // nni entries start at 16,777,216
+  replayPonPortBase = 0x2 << 28 // pon entries start at 536,870,912
+)
+
+// isReplayedStatsPortDown attributes a canned stats entry to one of the OLT
+// ports through its port number and reports whether that port is operationally
+// down. Entries that cannot be attributed to a port are replayed as-is
+func(o *OltDevice) isReplayedStatsPortDown(intfId uint32) bool {
+  switch {
+  case intfId >= replayPonPortBase:
+    if pon, err := o.GetPonById(intfId - replayPonPortBase); err == nil {
+      return pon.OperState.Current() == "down"
+    }
+  case intfId >= replayNniPortBase:
+    if nni, err := o.getNniById(intfId - replayNniPortBase); err == nil {
+      return nni.OperState.Current() == "down"
+    }
+  }
+  return false
+}
+
 func (o *OltDevice) send25GPortStatsIndication(stats *openolt.PortStatistics,stream openolt.Openolt_EnableIndicationServer) {
 	if o.InternalState.Current() == OltInternalStateEnabled {
 		oltLogger.WithFields(log.Fields{
 			"Stats": stats,
 		}).Debug("Sending port stats")
 //		stats.IntfId = InterfaceIDToPortNo(portID, portType)
-    if !latencyFlag {
+    if o.isReplayedStatsPortDown(stats.IntfId) {
+      // real hardware stops reporting on a dead port, suppress the entry
+      oltLogger.WithFields(log.Fields{
+        "IntfId": stats.IntfId,
+      }).Debug("Suppressing replayed stats for oper-down port")
+      return
+    }
+    // canned entries carry base-encoded port numbers: strip the base so the
+    // lookup matches the raw interface id the injection map is keyed by
+    rawIntfId := stats.IntfId
+    switch {
+    case rawIntfId >= replayPonPortBase:
+      rawIntfId -= replayPonPortBase
+    case rawIntfId >= replayNniPortBase:
+      rawIntfId -= replayNniPortBase
+    }
+    if errors, ok := o.injectedBipErrorsFor(rawIntfId); ok {
+      // an injected error count overrides both the replayed value and
+      // the latency flag
+      stats.BipErrors = errors
+    } else if !latencyFlag {
       stats.BipErrors = 0
 		  oltLogger.WithFields(log.Fields{
 			  "Stats": stats,
@@ -869,6 +1447,11 @@ func (o *OltDevice) processOltMessages(ctx context.Context, stream types.Stream,
 	}).Debug("Starting OLT Indication Channel")
 	ch := o.channel
 
+	atomic.StoreInt64(&o.lastOltMsgProcessed, deviceClock.Now().UnixNano())
+	if o.msgWatchdogInterval > 0 {
+		go o.watchOltMessages(ctx)
+	}
+
 loop:
 	for {
 		select {
@@ -944,6 +1527,7 @@ loop:
 			default:
 				oltLogger.Warnf("Received unknown message data %v for type %v in OLT Channel", message.Data, message.Type)
 			}
+			atomic.StoreInt64(&o.lastOltMsgProcessed, deviceClock.Now().UnixNano())
 		}
 	}
 	wg.Done()
@@ -952,6 +1536,31 @@ loop:
 	}).Warn("Stopped handling OLT Indication Channel")
 }
 
+// watchOltMessages periodically checks that processOltMessages keeps
+// draining the indication channel: when messages are pending but none was
+// processed within msgWatchdogInterval the loop is likely wedged (e.g. a
+// blocked send downstream), so log it and emit an event rather than letting
+// indications stop silently
+func (o *OltDevice) watchOltMessages(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-deviceClock.After(o.msgWatchdogInterval):
+			pending := len(o.channel)
+			last := time.Unix(0, atomic.LoadInt64(&o.lastOltMsgProcessed))
+			if pending > 0 && deviceClock.Now().Sub(last) >= o.msgWatchdogInterval {
+				oltLogger.WithFields(log.Fields{
+					"PendingMessages": pending,
+					"LastProcessed":   last,
+					"Interval":        o.msgWatchdogInterval,
+				}).Warn("OLT indication loop has not processed pending messages within the watchdog interval")
+				publishEvent("OLT-indication-loop-stuck", -1, -1, "")
+			}
+		}
+	}
+}
+
 // returns an ONU with a given Serial Number
 func (o *OltDevice) FindOnuBySn(serialNumber string) (*Onu, error) {
 	// NOTE this function can be a performance bottleneck when we have many ONUs,
@@ -1005,12 +1614,33 @@ func (o *OltDevice) ActivateOnu(context context.Context, onu *openolt.Onu) (*ope
 
 	pon, _ := o.GetPonById(onu.IntfId)
 
+	_onu, _ := pon.GetOnuBySn(onu.SerialNumber)
+
+	// an ONU out of the PON's optical budget cannot range: raise a LOS
+	// alarm instead of enabling it
+	if err := pon.checkOpticalBudget(_onu); err != nil {
+		oltLogger.WithFields(log.Fields{
+			"IntfId":     onu.IntfId,
+			"OnuId":      onu.OnuId,
+			"OnuSn":      _onu.Sn(),
+			"DistanceKm": _onu.DistanceKm,
+			"RxPower":    _onu.RxPower,
+			"err":        err,
+		}).Warn("Rejecting ONU activation, out of optical budget")
+		publishEvent("ONU-activation-out-of-optical-budget", int32(onu.IntfId), int32(onu.OnuId), _onu.Sn())
+		if alarmErr := _onu.SetAlarm("ONU_ALARM_LOS", "on"); alarmErr != nil {
+			oltLogger.WithFields(log.Fields{
+				"OnuSn": _onu.Sn(),
+				"err":   alarmErr,
+			}).Error("Cannot raise LOS alarm for out-of-budget ONU")
+		}
+		return new(openolt.Empty), err
+	}
+
 	// Enable the resource maps for this ONU
 	olt.AllocIDs[onu.IntfId][onu.OnuId] = make(map[uint32]map[int32]map[uint64]bool)
 	olt.GemPortIDs[onu.IntfId][onu.OnuId] = make(map[uint32]map[int32]map[uint64]bool)
 
-	_onu, _ := pon.GetOnuBySn(onu.SerialNumber)
-
 	publishEvent("ONU-activate-indication-received", int32(onu.IntfId), int32(onu.OnuId), _onu.Sn())
 	oltLogger.WithFields(log.Fields{
 		"OnuSn": _onu.Sn(),
@@ -1069,14 +1699,66 @@ func (o *OltDevice) DeleteOnu(_ context.Context, onu *openolt.Onu) (*openolt.Emp
 		}
 	}
 
+	// drop the BOSS provisioning and resource maps so a re-added ONU
+	// starts clean
+	o.removeOnuState(onu.IntfId, onu.OnuId)
+
 	// ONU Re-Discovery
 	if o.InternalState.Current() == OltInternalStateEnabled && pon.InternalState.Current() == "enabled" {
-		go _onu.ReDiscoverOnu(false)
+		if _onu.SuppressRediscovery {
+			oltLogger.WithFields(log.Fields{
+				"IntfId": _onu.PonPortID,
+				"OnuSn":  _onu.Sn(),
+				"OnuId":  _onu.ID,
+			}).Info("Not re-discovering ONU as automatic re-discovery is suppressed")
+		} else {
+			go _onu.ReDiscoverOnu(false)
+		}
 	}
 
 	return new(openolt.Empty), nil
 }
 
+// GetDhcpLeases returns the lease table of the embedded DHCP server, used
+// to verify that subscriber DHCP completed with the expected address
+func (o *OltDevice) GetDhcpLeases() []dhcp.DhcpLease {
+	return o.dhcpServer.GetLeases()
+}
+
+// rememberActiveOnus records the serial numbers of the ONUs that are
+// currently enabled so that ReenableOlt can restore them, replacing any
+// previously remembered set.
+func (o *OltDevice) rememberActiveOnus() {
+	active := make(map[string]struct{})
+	for _, pon := range o.Pons {
+		for _, onu := range pon.Onus {
+			if onu.InternalState.Current() == OnuStateEnabled {
+				active[onu.Sn()] = struct{}{}
+			}
+		}
+	}
+	o.activeOnusLock.Lock()
+	o.activeBeforeDisable = active
+	o.activeOnusLock.Unlock()
+}
+
+// wasActiveBeforeDisable reports whether the ONU was enabled when the OLT
+// was last disabled
+func (o *OltDevice) wasActiveBeforeDisable(sn string) bool {
+	o.activeOnusLock.Lock()
+	defer o.activeOnusLock.Unlock()
+	_, ok := o.activeBeforeDisable[sn]
+	return ok
+}
+
+// forgetActiveOnus drops the remembered ONU state, a rebooted OLT starts
+// from a clean slate
+func (o *OltDevice) forgetActiveOnus() {
+	o.activeOnusLock.Lock()
+	o.activeBeforeDisable = nil
+	o.activeOnusLock.Unlock()
+}
+
 func (o *OltDevice) DisableOlt(context.Context, *openolt.Empty) (*openolt.Empty, error) {
 	// NOTE when we disable the OLT should we disable NNI, PONs and ONUs altogether?
 	oltLogger.WithFields(log.Fields{
@@ -1084,6 +1766,10 @@ func (o *OltDevice) DisableOlt(context.Context, *openolt.Empty) (*openolt.Empty,
 	}).Info("Disabling OLT")
 	publishEvent("OLT-disable-received", -1, -1, "")
 
+	// remember which ONUs are active so a subsequent ReenableOlt can
+	// restore them
+	o.rememberActiveOnus()
+
 	for _, pon := range o.Pons {
 		if pon.InternalState.Current() == "enabled" {
 			// disable PONs
@@ -1113,24 +1799,66 @@ func (o *OltDevice) DisableOlt(context.Context, *openolt.Empty) (*openolt.Empty,
 	return new(openolt.Empty), nil
 }
 
-func (o *OltDevice) DisablePonIf(_ context.Context, intf *openolt.Interface) (*openolt.Empty, error) {
-	oltLogger.Infof("DisablePonIf request received for PON %d", intf.IntfId)
-	ponID := intf.GetIntfId()
-	pon, _ := o.GetPonById(intf.IntfId)
+// DrainAndDisable explicitly disables every ONU (firing OnuTxDisable so the
+// appropriate indications are sent) before disabling the OLT itself,
+// producing a deterministic "everything down" state. DisableOlt deliberately
+// leaves the ONU admin state untouched, this is the full teardown variant.
+func (o *OltDevice) DrainAndDisable() error {
+	if o.InternalState.Current() != OltInternalStateEnabled {
+		return fmt.Errorf("cannot-drain-olt-in-state-%s", o.InternalState.Current())
+	}
+
+	oltLogger.WithFields(log.Fields{
+		"oltId": o.ID,
+	}).Info("Draining and disabling OLT")
+	publishEvent("OLT-drain-received", -1, -1, "")
+
+	for _, pon := range o.Pons {
+		for _, onu := range pon.Onus {
+			if onu.InternalState.Cannot(OnuTxDisable) {
+				continue
+			}
+			if err := onu.InternalState.Event(OnuTxDisable); err != nil {
+				oltLogger.WithFields(log.Fields{
+					"IntfId": onu.PonPortID,
+					"OnuSn":  onu.Sn(),
+				}).Errorf("Cannot disable ONU: %v", err)
+			}
+		}
+	}
+
+	_, err := o.DisableOlt(context.Background(), new(openolt.Empty))
+	return err
+}
+
+// SetPonIfState brings a PON port operationally up or down, optionally
+// cascading the matching indication to every ONU on the port. The OpenOLT
+// EnablePonIf/DisablePonIf handlers always cascade; test scenarios that want
+// the PON state to change without touching the ONU reported state can call
+// this directly with cascadeOnus false
+func (o *OltDevice) SetPonIfState(ponID uint32, operState types.OperState, cascadeOnus bool) error {
+	pon, err := o.GetPonById(ponID)
+	if err != nil {
+		return err
+	}
 
 	msg := types.Message{
 		Type: types.PonIndication,
 		Data: types.PonIndicationMessage{
-			OperState: types.DOWN,
+			OperState: operState,
 			PonPortID: ponID,
 		},
 	}
 	o.channel <- msg
 
+	if !cascadeOnus {
+		return nil
+	}
+
 	for _, onu := range pon.Onus {
 
 		onuIndication := types.OnuIndicationMessage{
-			OperState: types.DOWN,
+			OperState: operState,
 			PonPortID: ponID,
 			OnuID:     onu.ID,
 			OnuSN:     onu.SerialNumber,
@@ -1139,6 +1867,16 @@ func (o *OltDevice) DisablePonIf(_ context.Context, intf *openolt.Interface) (*o
 
 	}
 
+	return nil
+}
+
+func (o *OltDevice) DisablePonIf(_ context.Context, intf *openolt.Interface) (*openolt.Empty, error) {
+	oltLogger.Infof("DisablePonIf request received for PON %d", intf.IntfId)
+
+	if err := o.SetPonIfState(intf.GetIntfId(), types.DOWN, true); err != nil {
+		return nil, err
+	}
+
 	return new(openolt.Empty), nil
 }
 
@@ -1150,28 +1888,9 @@ func (o *OltDevice) EnableIndication(_ *openolt.Empty, stream openolt.Openolt_En
 
 func (o *OltDevice) EnablePonIf(_ context.Context, intf *openolt.Interface) (*openolt.Empty, error) {
 	oltLogger.Infof("EnablePonIf request received for PON %d", intf.IntfId)
-	ponID := intf.GetIntfId()
-	pon, _ := o.GetPonById(intf.IntfId)
-
-	msg := types.Message{
-		Type: types.PonIndication,
-		Data: types.PonIndicationMessage{
-			OperState: types.UP,
-			PonPortID: ponID,
-		},
-	}
-	o.channel <- msg
-
-	for _, onu := range pon.Onus {
-
-		onuIndication := types.OnuIndicationMessage{
-			OperState: types.UP,
-			PonPortID: ponID,
-			OnuID:     onu.ID,
-			OnuSN:     onu.SerialNumber,
-		}
-		onu.sendOnuIndication(onuIndication, o.OpenoltStream)
 
+	if err := o.SetPonIfState(intf.GetIntfId(), types.UP, true); err != nil {
+		return nil, err
 	}
 
 	return new(openolt.Empty), nil
@@ -1217,7 +1936,14 @@ func (o *OltDevice) FlowAdd(ctx context.Context, flow *openolt.Flow) (*openolt.E
 			"SrcPort":          flow.Classifier.SrcPort,
 			"UniID":            flow.UniId,
 			"ClassifierOPbits": flow.Classifier.OPbits,
+			"GroupId":          flow.GroupId,
 		}).Debug("Adding OLT multicast flow")
+
+		// remember the flow so downstream multicast traffic arriving via
+		// UplinkPacketOut can be replicated to the group members
+		o.mcastFlowsLock.Lock()
+		o.mcastFlows[flow.FlowId] = flow
+		o.mcastFlowsLock.Unlock()
 	} else {
 		pon, err := o.GetPonById(uint32(flow.AccessIntfId))
 		if err != nil {
@@ -1261,13 +1987,22 @@ func (o *OltDevice) FlowAdd(ctx context.Context, flow *openolt.Flow) (*openolt.E
 
 		// validate that the flow reference correct IDs (Alloc, Gem)
 		if err := o.validateFlow(flow); err != nil {
-			oltLogger.WithFields(log.Fields{
+			fields := log.Fields{
 				"OnuId":        flow.OnuId,
 				"IntfId":       flow.AccessIntfId,
 				"Flow":         flow,
 				"SerialNumber": onu.Sn(),
 				"err":          err,
-			}).Error("invalid-flow-for-onu")
+			}
+			var conflict FlowConflict
+			if errors.As(err, &conflict) {
+				fields["ConflictKind"] = conflict.Kind
+				fields["ConflictId"] = conflict.Id
+				fields["ConflictOnuId"] = conflict.OnuId
+				fields["ConflictUniId"] = conflict.UniId
+				fields["ConflictFlowId"] = conflict.OtherFlowId
+			}
+			oltLogger.WithFields(fields).Error("invalid-flow-for-onu")
 			return nil, err
 		}
 
@@ -1282,7 +2017,13 @@ func (o *OltDevice) FlowAdd(ctx context.Context, flow *openolt.Flow) (*openolt.E
 				Flow:      flow,
 			},
 		}
-		onu.Channel <- msg
+		if err := onu.sendMessage(msg); err != nil {
+			oltLogger.WithFields(log.Fields{
+				"IntfId": flow.AccessIntfId,
+				"OnuId":  flow.OnuId,
+				"err":    err,
+			}).Warn("Dropping FlowAdd message for the ONU")
+		}
 	}
 
 	return new(openolt.Empty), nil
@@ -1356,6 +2097,10 @@ func (o *OltDevice) FlowRemove(_ context.Context, flow *openolt.Flow) (*openolt.
 		oltLogger.WithFields(log.Fields{
 			"FlowId": flow.FlowId,
 		}).Debug("Removing OLT multicast flow")
+
+		o.mcastFlowsLock.Lock()
+		delete(o.mcastFlows, flow.FlowId)
+		o.mcastFlowsLock.Unlock()
 	} else {
 
 		onu, err := o.GetOnuByFlowId(flow.FlowId)
@@ -1374,13 +2119,137 @@ func (o *OltDevice) FlowRemove(_ context.Context, flow *openolt.Flow) (*openolt.
 				Flow: flow,
 			},
 		}
-		onu.Channel <- msg
+		if err := onu.sendMessage(msg); err != nil {
+			oltLogger.WithFields(log.Fields{
+				"IntfId": flow.AccessIntfId,
+				"OnuId":  flow.OnuId,
+				"err":    err,
+			}).Warn("Dropping FlowRemoved message for the ONU")
+			// the ONU processing loop is not running (e.g. the ONU is
+			// disabled or rebooting) so it will never prune the flow id
+			// itself: do it synchronously to avoid leaving it stale
+			for idx, id := range onu.FlowIds {
+				if id == flow.FlowId {
+					onu.FlowIds = append(onu.FlowIds[:idx], onu.FlowIds[idx+1:]...)
+					break
+				}
+			}
+		}
 	}
 
 	return new(openolt.Empty), nil
 }
 
+// SetPonTechnology changes the technology of a PON port at runtime, e.g. to
+// simulate an in-service upgrade from XGS-PON to 25G-PON. The configured
+// resource ranges are updated so the next GetDeviceInfo reports the new
+// technology for this port.
+func (o *OltDevice) SetPonTechnology(ponId uint32, technology string) error {
+	pon, err := o.GetPonById(ponId)
+	if err != nil {
+		return err
+	}
+
+	tech, err := common.PonTechnologyFromString(technology)
+	if err != nil {
+		return err
+	}
+
+	if err := common.SetPonTechnology(ponId, technology); err != nil {
+		return err
+	}
+
+	oldTech := pon.Technology
+	pon.Technology = tech
+
+	oltLogger.WithFields(log.Fields{
+		"IntfId":        ponId,
+		"OldTechnology": oldTech.String(),
+		"Technology":    tech.String(),
+	}).Info("Changed PON port technology")
+
+	return nil
+}
+
+// ClearFlows removes all the stored flows installed on a PON port, or only
+// the ones belonging to a single ONU when onuId is not negative. Each flow
+// goes through FlowRemove so alloc-ids, gem-ports and the ONU messages are
+// handled exactly as for a single removal. It returns the number of flows
+// that were removed.
+func (o *OltDevice) ClearFlows(ponId int32, onuId int32) (int, error) {
+	if o.enablePerf {
+		return 0, fmt.Errorf("flows-are-not-stored-when-enablePerf-is-true")
+	}
+
+	flows := []openolt.Flow{}
+	o.Flows.Range(func(_, value interface{}) bool {
+		flow := value.(openolt.Flow)
+		if flow.AccessIntfId == ponId && (onuId < 0 || flow.OnuId == onuId) {
+			flows = append(flows, flow)
+		}
+		return true
+	})
+
+	for i := range flows {
+		if _, err := o.FlowRemove(context.Background(), &flows[i]); err != nil {
+			return i, err
+		}
+	}
+
+	oltLogger.WithFields(log.Fields{
+		"IntfId": ponId,
+		"OnuId":  onuId,
+		"Flows":  len(flows),
+	}).Info("Cleared flows")
+
+	return len(flows), nil
+}
+
+// InjectHeartbeatFailure makes HeartbeatCheck fail for the given duration,
+// simulating a flaky management channel without stopping the gRPC server
+func (o *OltDevice) InjectHeartbeatFailure(duration time.Duration) {
+	o.heartbeatFailUntil = deviceClock.Now().Add(duration)
+}
+
+// newSignature produces the next OLT signature according to the configured
+// generation mode, defaulting to the historical unix-seconds value
+func (o *OltDevice) newSignature() uint32 {
+	switch o.signatureMode {
+	case "random":
+		return rand.Uint32()
+	case "counter":
+		return atomic.AddUint32(&o.signatureCounter, 1)
+	case "fixed":
+		return o.signatureFixed
+	default:
+		return uint32(deviceClock.Now().Unix())
+	}
+}
+
+// ForceSignatureChange replaces the OLT signature without performing a
+// reboot so the controller sees a signature mismatch on the next
+// HeartbeatCheck, with signature 0 a freshly generated one is picked
+func (o *OltDevice) ForceSignatureChange(signature uint32) uint32 {
+	if signature == 0 {
+		signature = o.newSignature()
+	}
+	oldSignature := o.signature
+	o.signature = signature
+	oltLogger.WithFields(log.Fields{
+		"OldSignature": oldSignature,
+		"NewSignature": o.signature,
+	}).Info("Forcing OLT signature change")
+	publishSignatureChangeEvent(oldSignature, o.signature)
+	return o.signature
+}
+
 func (o *OltDevice) HeartbeatCheck(context.Context, *openolt.Empty) (*openolt.Heartbeat, error) {
+	if deviceClock.Now().Before(o.heartbeatFailUntil) {
+		oltLogger.WithFields(log.Fields{
+			"failUntil": o.heartbeatFailUntil,
+		}).Warn("Failing HeartbeatCheck as failure injection is active")
+		return nil, fmt.Errorf("heartbeat-failure-injected")
+	}
 	res := openolt.Heartbeat{HeartbeatSignature: o.signature}
 	oltLogger.WithFields(log.Fields{
 		"signature": res.HeartbeatSignature,
@@ -1420,25 +2289,31 @@ func (o *OltDevice) GetDeviceInfo(context.Context, *openolt.Empty) (*openolt.Dev
 			intfIDs = append(intfIDs, uint32(i))
 		}
 
+		// the sharing mode is DEDICATED_PER_INTF unless the range overrides it
+		sharing := openolt.DeviceInfo_DeviceResourceRanges_Pool_DEDICATED_PER_INTF
+		if resRange.PoolSharing != "" {
+			sharing = openolt.DeviceInfo_DeviceResourceRanges_Pool_SharingType(openolt.DeviceInfo_DeviceResourceRanges_Pool_SharingType_value[resRange.PoolSharing])
+		}
+
 		devinfo.Ranges = append(devinfo.Ranges, &openolt.DeviceInfo_DeviceResourceRanges{
 			IntfIds:    intfIDs,
-			Technology: "ETRI-PON",
+			Technology: resRange.Technology,
 			Pools: []*openolt.DeviceInfo_DeviceResourceRanges_Pool{
 				{
 					Type:    openolt.DeviceInfo_DeviceResourceRanges_Pool_ONU_ID,
-					Sharing: openolt.DeviceInfo_DeviceResourceRanges_Pool_DEDICATED_PER_INTF,
+					Sharing: sharing,
 					Start:   resRange.OnuRange.StartId,
 					End:     resRange.OnuRange.EndId,
 				},
 				{
 					Type:    openolt.DeviceInfo_DeviceResourceRanges_Pool_ALLOC_ID,
-					Sharing: openolt.DeviceInfo_DeviceResourceRanges_Pool_DEDICATED_PER_INTF,
+					Sharing: sharing,
 					Start:   resRange.AllocIdRange.StartId,
 					End:     resRange.AllocIdRange.EndId,
 				},
 				{
 					Type:    openolt.DeviceInfo_DeviceResourceRanges_Pool_GEMPORT_ID,
-					Sharing: openolt.DeviceInfo_DeviceResourceRanges_Pool_DEDICATED_PER_INTF,
+					Sharing: sharing,
 					Start:   resRange.GemportRange.StartId,
 					End:     resRange.GemportRange.EndId,
 				},
@@ -1465,6 +2340,23 @@ func (o *OltDevice) GetDeviceInfo(context.Context, *openolt.Empty) (*openolt.Dev
 	return devinfo, nil
 }
 
+const (
+	// smallest openolt OMCI payload worth handing to the parser: transaction
+	// id, message type, device identifier and at least one content byte
+	minOmciMsgSize = 10
+	// cap on how many packet bytes are hex-dumped in error logs
+	maxOmciLogBytes = 64
+)
+
+// omciPktForLog hex-encodes a packet for logging, truncating it so a huge
+// payload does not flood the logs
+func omciPktForLog(pkt []byte) string {
+	if len(pkt) > maxOmciLogBytes {
+		return fmt.Sprintf("%s...(%d bytes total)", hex.EncodeToString(pkt[:maxOmciLogBytes]), len(pkt))
+	}
+	return hex.EncodeToString(pkt)
+}
+
 func (o *OltDevice) OmciMsgOut(ctx context.Context, omci_msg *openolt.OmciMsg) (*openolt.Empty, error) {
 	pon, err := o.GetPonById(omci_msg.IntfId)
 	if err != nil {
@@ -1491,12 +2383,23 @@ func (o *OltDevice) OmciMsgOut(ctx context.Context, omci_msg *openolt.OmciMsg) (
 		"OnuId":  onu.ID,
 		"OnuSn":  onu.Sn(),
 	}).Debugf("Received OmciMsgOut")
+	if len(omci_msg.Pkt) < minOmciMsgSize {
+		// too short to even hold the OMCI header: report truncation
+		// explicitly rather than a generic parse failure
+		log.WithFields(log.Fields{
+			"IntfId":       onu.PonPortID,
+			"SerialNumber": onu.Sn(),
+			"omciPacket":   omciPktForLog(omci_msg.Pkt),
+			"length":       len(omci_msg.Pkt),
+		}).Error("omci-packet-truncated")
+		return nil, fmt.Errorf("olt-received-truncated-omci-packet")
+	}
 	omciPkt, omciMsg, err := omcilib.ParseOpenOltOmciPacket(omci_msg.Pkt)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"IntfId":       onu.PonPortID,
 			"SerialNumber": onu.Sn(),
-			"omciPacket":   hex.EncodeToString(omci_msg.Pkt),
+			"omciPacket":   omciPktForLog(omci_msg.Pkt),
 			"err":          err.Error(),
 		}).Error("cannot-parse-OMCI-packet")
 		return nil, fmt.Errorf("olt-received-malformed-omci-packet")
@@ -1520,7 +2423,9 @@ func (o *OltDevice) OmciMsgOut(ctx context.Context, omci_msg *openolt.OmciMsg) (
 				OmciPkt: omciPkt,
 			},
 		}
-		onu.Channel <- msg
+		if err := onu.enqueueOmciMessage(msg); err != nil {
+			return nil, status.Errorf(codes.ResourceExhausted, "%v", err)
+		}
 	}
 	return new(openolt.Empty), nil
 }
@@ -1551,8 +2456,42 @@ func (o *OltDevice) OnuPacketOut(ctx context.Context, onuPkt *openolt.OnuPacket)
 		"Packet": hex.EncodeToString(onuPkt.Pkt),
 	}).Debug("Received OnuPacketOut")
 
+	if onu.InternalState.Current() == OnuStateDisabled {
+		// if the ONU is disabled drop the packet rather than
+		// queueing it on the ONU channel, but keep count of it
+		atomic.AddUint64(&onu.DroppedPackets, 1)
+		oltLogger.WithFields(log.Fields{
+			"IntfId":         onuPkt.IntfId,
+			"OnuId":          onuPkt.OnuId,
+			"OnuSn":          onu.Sn(),
+			"DroppedPackets": atomic.LoadUint64(&onu.DroppedPackets),
+		}).Warn("dropping-onu-packet-out-onu-disabled")
+		return new(openolt.Empty), nil
+	}
+
+	if pon.dropPacket() {
+		oltLogger.WithFields(log.Fields{
+			"IntfId":         onuPkt.IntfId,
+			"OnuId":          onuPkt.OnuId,
+			"DroppedPackets": atomic.LoadUint64(&pon.DroppedPackets),
+		}).Debug("Dropping OnuPacketOut packet because of loss injection")
+		return new(openolt.Empty), nil
+	}
+
 	rawpkt := gopacket.NewPacket(onuPkt.Pkt, layers.LayerTypeEthernet, gopacket.Default)
 
+	if !packetHandlers.IsEthernetPacket(rawpkt) {
+		atomic.AddUint64(&o.MalformedPackets, 1)
+		oltLogger.WithFields(log.Fields{
+			"IntfId":           onuPkt.IntfId,
+			"OnuId":            onuPkt.OnuId,
+			"OnuSn":            onu.Sn(),
+			"Pkt":              hex.EncodeToString(onuPkt.Pkt),
+			"MalformedPackets": atomic.LoadUint64(&o.MalformedPackets),
+		}).Warn("Dropping OnuPacketOut packet that does not decode as Ethernet")
+		return new(openolt.Empty), nil
+	}
+
 	pktType, err := packetHandlers.GetPktType(rawpkt)
 	if err != nil {
 		onuLogger.WithFields(log.Fields{
@@ -1587,11 +2526,38 @@ func (o *OltDevice) OnuPacketOut(ctx context.Context, onuPkt *openolt.OnuPacket)
 		},
 	}
 
-	onu.Channel <- msg
+	o.deliverWithDelay(pon.packetDelay(), func() {
+		if err := onu.sendMessage(msg); err != nil {
+			oltLogger.WithFields(log.Fields{
+				"IntfId": onuPkt.IntfId,
+				"OnuId":  onuPkt.OnuId,
+				"err":    err,
+			}).Warn("Dropping OnuPacketOut message for the ONU")
+		}
+	})
 
 	return new(openolt.Empty), nil
 }
 
+// deliverWithDelay delivers a data-plane packet after the given artificial delay
+// without blocking the gRPC handler. Delivery is skipped if the enable context
+// is canceled while the packet is in flight.
+func (o *OltDevice) deliverWithDelay(delay time.Duration, deliver func()) {
+	if delay == 0 {
+		deliver()
+		return
+	}
+	ctx := o.enableContext
+	go func() {
+		select {
+		case <-deviceClock.After(delay):
+			deliver()
+		case <-ctx.Done():
+			oltLogger.Debug("Dropping delayed packet as the enable context was canceled")
+		}
+	}()
+}
+
 func (o *OltDevice) Reboot(context.Context, *openolt.Empty) (*openolt.Empty, error) {
 
 	// OLT Reboot is called in two cases:
@@ -1640,16 +2606,144 @@ func (o *OltDevice) ReenableOlt(context.Context, *openolt.Empty) (*openolt.Empty
 func (o *OltDevice) UplinkPacketOut(context context.Context, packet *openolt.UplinkPacket) (*openolt.Empty, error) {
 	pkt := gopacket.NewPacket(packet.Pkt, layers.LayerTypeEthernet, gopacket.Default)
 
-	err := o.Nnis[0].handleNniPacket(pkt) // FIXME we are assuming we have only one NNI
+	if !packetHandlers.IsEthernetPacket(pkt) {
+		atomic.AddUint64(&o.MalformedPackets, 1)
+		oltLogger.WithFields(log.Fields{
+			"IntfId":           packet.IntfId,
+			"Pkt":              hex.EncodeToString(packet.Pkt),
+			"MalformedPackets": atomic.LoadUint64(&o.MalformedPackets),
+		}).Warn("Dropping UplinkPacketOut packet that does not decode as Ethernet")
+		return new(openolt.Empty), nil
+	}
 
-	if err != nil {
-		return nil, err
+	if o.Pons[0].dropPacket() {
+		oltLogger.WithFields(log.Fields{
+			"IntfId":         packet.IntfId,
+			"DroppedPackets": atomic.LoadUint64(&o.Pons[0].DroppedPackets),
+		}).Debug("Dropping UplinkPacketOut packet because of loss injection")
+		return new(openolt.Empty), nil
 	}
+
+	// downstream traffic sent to the address of an installed multicast flow
+	// is replicated to the ONUs that joined the flow's group instead of
+	// being handed to the NNI responders
+	if mcastFlow := o.matchMcastFlow(pkt); mcastFlow != nil {
+		o.deliverWithDelay(o.Pons[0].packetDelay(), func() {
+			o.deliverMcastPacket(mcastFlow, pkt)
+		})
+		return new(openolt.Empty), nil
+	}
+
+	// the UplinkPacket does not carry the PON the packet traversed,
+	// so we use the delay of the first PON port (as above for the NNI)
+	o.deliverWithDelay(o.Pons[0].packetDelay(), func() {
+		if err := o.Nnis[0].handleNniPacket(pkt); err != nil { // FIXME we are assuming we have only one NNI
+			oltLogger.WithFields(log.Fields{
+				"IntfId": packet.IntfId,
+				"err":    err,
+			}).Error("Cannot handle uplink packet")
+		}
+	})
+
 	return new(openolt.Empty), nil
 }
 
+// matchMcastFlow returns the installed multicast flow whose classifier
+// destination IP matches the packet, or nil if the packet is not multicast
+// traffic we know how to deliver
+func (o *OltDevice) matchMcastFlow(pkt gopacket.Packet) *openolt.Flow {
+	ipLayer := pkt.Layer(layers.LayerTypeIPv4)
+	if ipLayer == nil {
+		return nil
+	}
+	ip, _ := ipLayer.(*layers.IPv4)
+	if !ip.DstIP.IsMulticast() {
+		return nil
+	}
+	dstIp := binary.BigEndian.Uint32(ip.DstIP.To4())
+
+	o.mcastFlowsLock.RLock()
+	defer o.mcastFlowsLock.RUnlock()
+	for _, flow := range o.mcastFlows {
+		if flow.Classifier.DstIp == dstIp {
+			return flow
+		}
+	}
+	return nil
+}
+
+// deliverMcastPacket replicates a downstream multicast packet to every ONU
+// that joined the flow's group, ONUs that are not members never see it
+func (o *OltDevice) deliverMcastPacket(flow *openolt.Flow, pkt gopacket.Packet) {
+	for _, pon := range o.Pons {
+		for _, onu := range pon.Onus {
+			if !onu.inMcastGroup(flow.GroupId) {
+				continue
+			}
+			onu.countFecCodewords(len(pkt.Data()))
+			atomic.AddUint64(&onu.McastRxPackets, 1)
+			oltLogger.WithFields(log.Fields{
+				"IntfId":  onu.PonPortID,
+				"OnuId":   onu.ID,
+				"OnuSn":   onu.Sn(),
+				"GroupId": flow.GroupId,
+				"FlowId":  flow.FlowId,
+			}).Debug("Replicated multicast packet to group member")
+		}
+	}
+}
+
+// PortCountersSnapshot holds the counters of a single port at snapshot time
+type PortCountersSnapshot struct {
+	ID             uint32
+	PacketCount    uint64
+	DroppedPackets uint64
+}
+
+// StatsSnapshot is a point-in-time copy of the device-wide counters
+type StatsSnapshot struct {
+	PktInCount    uint64
+	DroppedEvents uint64
+	Nnis          []PortCountersSnapshot
+	Pons          []PortCountersSnapshot
+}
+
+// TakeStatsSnapshot copies all the counter families under the stats lock,
+// so that the returned values are consistent with each other
+func (o *OltDevice) TakeStatsSnapshot() *StatsSnapshot {
+	o.statsLock.Lock()
+	defer o.statsLock.Unlock()
+
+	snapshot := &StatsSnapshot{
+		PktInCount:    common.GetPktIndCount(),
+		DroppedEvents: atomic.LoadUint64(&o.droppedEvents),
+		Nnis:          make([]PortCountersSnapshot, 0, len(o.Nnis)),
+		Pons:          make([]PortCountersSnapshot, 0, len(o.Pons)),
+	}
+	for _, nni := range o.Nnis {
+		snapshot.Nnis = append(snapshot.Nnis, PortCountersSnapshot{
+			ID:          nni.ID,
+			PacketCount: nni.PacketCount,
+		})
+	}
+	for _, pon := range o.Pons {
+		snapshot.Pons = append(snapshot.Pons, PortCountersSnapshot{
+			ID:             pon.ID,
+			PacketCount:    pon.PacketCount,
+			DroppedPackets: atomic.LoadUint64(&pon.DroppedPackets),
+		})
+	}
+	return snapshot
+}
+
 func (o *OltDevice) CollectStatistics(context.Context, *openolt.Empty) (*openolt.Empty, error) {
-	oltLogger.Error("CollectStatistics not implemented")
+	snapshot := o.TakeStatsSnapshot()
+	oltLogger.WithFields(log.Fields{
+		"PktInCount":    snapshot.PktInCount,
+		"DroppedEvents": snapshot.DroppedEvents,
+		"Nnis":          len(snapshot.Nnis),
+		"Pons":          len(snapshot.Pons),
+	}).Info("received CollectStatistics")
 	return new(openolt.Empty), nil
 }
 
@@ -1663,8 +2757,26 @@ func (o *OltDevice) GetPonIf(context context.Context, packet *openolt.Interface)
 	return new(openolt.IntfIndication), nil
 }
 
-func (s *OltDevice) CreateTrafficQueues(context.Context, *tech_profile.TrafficQueues) (*openolt.Empty, error) {
+func (s *OltDevice) CreateTrafficQueues(_ context.Context, tq *tech_profile.TrafficQueues) (*openolt.Empty, error) {
 	oltLogger.Info("received CreateTrafficQueues")
+
+	// remember which gem ports were provisioned with AES encryption so the
+	// provisioning can be verified through GemPortEncrypted
+	s.gemPortEncryptionLock.Lock()
+	for _, queue := range tq.TrafficQueues {
+		if _, ok := s.gemPortEncryption[tq.IntfId]; !ok {
+			s.gemPortEncryption[tq.IntfId] = make(map[uint32]bool)
+		}
+		s.gemPortEncryption[tq.IntfId][queue.GemportId] = queue.AesEncryption
+		oltLogger.WithFields(log.Fields{
+			"IntfId":        tq.IntfId,
+			"OnuId":         tq.OnuId,
+			"GemportId":     queue.GemportId,
+			"AesEncryption": queue.AesEncryption,
+		}).Debug("Recorded gem port encryption state")
+	}
+	s.gemPortEncryptionLock.Unlock()
+
 	return new(openolt.Empty), nil
 }
 
@@ -1675,9 +2787,24 @@ func (s *OltDevice) RemoveTrafficQueues(_ context.Context, tq *tech_profile.Traf
 		"OnuPortNo": tq.PortNo,
 		"UniId":     tq.UniId,
 	}).Info("received RemoveTrafficQueues")
+
+	s.gemPortEncryptionLock.Lock()
+	for _, queue := range tq.TrafficQueues {
+		delete(s.gemPortEncryption[tq.IntfId], queue.GemportId)
+	}
+	s.gemPortEncryptionLock.Unlock()
+
 	return new(openolt.Empty), nil
 }
 
+// GemPortEncrypted reports whether the gem port was provisioned with AES
+// encryption through the traffic queues
+func (o *OltDevice) GemPortEncrypted(intfId uint32, gemPortId uint32) bool {
+	o.gemPortEncryptionLock.RLock()
+	defer o.gemPortEncryptionLock.RUnlock()
+	return o.gemPortEncryption[intfId][gemPortId]
+}
+
 func (s *OltDevice) CreateTrafficSchedulers(_ context.Context, trafficSchedulers *tech_profile.TrafficSchedulers) (*openolt.Empty, error) {
 	oltLogger.WithFields(log.Fields{
 		"OnuId":     trafficSchedulers.OnuId,
@@ -1720,11 +2847,51 @@ func (s *OltDevice) RemoveTrafficSchedulers(context context.Context, trafficSche
 			return new(openolt.Empty), err
 		}
 
+		// only remove the schedulers if they match what was created,
+		// a mismatch means the adapter is targeting the wrong ONU
+		if onu.TrafficSchedulers == nil {
+			oltLogger.WithFields(log.Fields{
+				"OnuId":  trafficSchedulers.OnuId,
+				"IntfId": trafficSchedulers.IntfId,
+			}).Warn("received RemoveTrafficSchedulers for ONU without installed schedulers")
+			return new(openolt.Empty), fmt.Errorf("no-traffic-schedulers-installed-on-onu-%s", onu.Sn())
+		}
+		if onu.TrafficSchedulers.UniId != trafficSchedulers.UniId ||
+			onu.TrafficSchedulers.PortNo != trafficSchedulers.PortNo {
+			oltLogger.WithFields(log.Fields{
+				"OnuId":           trafficSchedulers.OnuId,
+				"IntfId":          trafficSchedulers.IntfId,
+				"UniId":           trafficSchedulers.UniId,
+				"PortNo":          trafficSchedulers.PortNo,
+				"InstalledUniId":  onu.TrafficSchedulers.UniId,
+				"InstalledPortNo": onu.TrafficSchedulers.PortNo,
+			}).Warn("received RemoveTrafficSchedulers not matching the installed schedulers")
+			return new(openolt.Empty), fmt.Errorf("traffic-schedulers-mismatch-on-onu-%s", onu.Sn())
+		}
+
 		onu.TrafficSchedulers = nil
 	}
 	return new(openolt.Empty), nil
 }
 
+// GetTrafficSchedulers returns the traffic schedulers currently installed on
+// the ONU identified by intf/onu id, erroring if the ONU is unknown or no
+// schedulers are installed
+func (o *OltDevice) GetTrafficSchedulers(intfID uint32, onuID uint32) (*tech_profile.TrafficSchedulers, error) {
+	pon, err := o.GetPonById(intfID)
+	if err != nil {
+		return nil, err
+	}
+	onu, err := pon.GetOnuById(onuID)
+	if err != nil {
+		return nil, err
+	}
+	if onu.TrafficSchedulers == nil {
+		return nil, fmt.Errorf("no-traffic-schedulers-installed-on-onu-%s", onu.Sn())
+	}
+	return onu.TrafficSchedulers, nil
+}
+
 func (o *OltDevice) PerformGroupOperation(ctx context.Context, group *openolt.Group) (*openolt.Empty, error) {
 	oltLogger.WithFields(log.Fields{
 		"GroupId": group.GroupId,
@@ -1732,9 +2899,48 @@ func (o *OltDevice) PerformGroupOperation(ctx context.Context, group *openolt.Gr
 		"Members": group.Members,
 		"Action":  group.Action,
 	}).Debug("received PerformGroupOperation")
+
+	// mirror the group membership on the ONUs so the multicast OMCI MEs
+	// report the data-plane state, members are resolved through the gem port
+	// they were provisioned with
+	for _, member := range group.Members {
+		onu := o.findOnuByMcastMember(member)
+		if onu == nil {
+			oltLogger.WithFields(log.Fields{
+				"GroupId":   group.GroupId,
+				"IntfId":    member.InterfaceId,
+				"GemPortId": member.GemPortId,
+			}).Warn("cannot-resolve-group-member-to-an-onu")
+			continue
+		}
+		switch group.Command {
+		case openolt.Group_ADD_MEMBERS, openolt.Group_SET_MEMBERS:
+			onu.joinMcastGroup(group.GroupId)
+		case openolt.Group_REMOVE_MEMBERS:
+			onu.leaveMcastGroup(group.GroupId)
+		}
+	}
 	return &openolt.Empty{}, nil
 }
 
+// findOnuByMcastMember resolves a multicast group member to the ONU owning
+// its gem port. The shared multicast gem port cannot identify a single ONU
+func (o *OltDevice) findOnuByMcastMember(member *openolt.GroupMember) *Onu {
+	pon, err := o.GetPonById(member.InterfaceId)
+	if err != nil {
+		return nil
+	}
+	used, sn := pon.isGemPortAllocated(uint16(member.GemPortId))
+	if !used {
+		return nil
+	}
+	onu, err := o.FindOnuBySn(common.OnuSnToString(sn))
+	if err != nil {
+		return nil
+	}
+	return onu
+}
+
 func (o *OltDevice) DeleteGroup(ctx context.Context, group *openolt.Group) (*openolt.Empty, error) {
 	oltLogger.WithFields(log.Fields{
 		"GroupId": group.GroupId,
@@ -1742,6 +2948,13 @@ func (o *OltDevice) DeleteGroup(ctx context.Context, group *openolt.Group) (*ope
 		"Members": group.Members,
 		"Action":  group.Action,
 	}).Debug("received PerformGroupOperation")
+
+	// deleting the group removes it from every ONU that joined it
+	for _, pon := range o.Pons {
+		for _, onu := range pon.Onus {
+			onu.leaveMcastGroup(group.GroupId)
+		}
+	}
 	return &openolt.Empty{}, nil
 }
 
@@ -1758,11 +2971,34 @@ func (o *OltDevice) GetLogicalOnuDistanceZero(ctx context.Context, in *openolt.O
 }
 
 func (o *OltDevice) GetLogicalOnuDistance(ctx context.Context, in *openolt.Onu) (*openolt.OnuLogicalDistance, error) {
-	return &openolt.OnuLogicalDistance{}, nil
+	onu, err := o.FindOnuById(in.IntfId, in.OnuId)
+	if err != nil {
+		return &openolt.OnuLogicalDistance{}, err
+	}
+	return &openolt.OnuLogicalDistance{
+		IntfId:             in.IntfId,
+		OnuId:              in.OnuId,
+		LogicalOnuDistance: onu.DistanceKm,
+	}, nil
 }
 
 func (o *OltDevice) GetPonRxPower(ctx context.Context, in *openolt.Onu) (*openolt.PonRxPowerData, error) {
-	return &openolt.PonRxPowerData{}, nil
+	onu, err := o.FindOnuById(in.IntfId, in.OnuId)
+	if err != nil {
+		oltLogger.WithFields(log.Fields{
+			"OnuId":  in.OnuId,
+			"IntfId": in.IntfId,
+			"err":    err,
+		}).Error("Can't find Onu")
+		return nil, err
+	}
+
+	return &openolt.PonRxPowerData{
+		IntfId:         in.IntfId,
+		OnuId:          in.OnuId,
+		Status:         "success",
+		RxPowerMeanDbm: onu.RxPower,
+	}, nil
 }
 
 func (o *OltDevice) GetGemPortStatistics(ctx context.Context, in *openolt.OnuPacket) (*openolt.GemPortStatistics, error) {
@@ -1770,10 +3006,61 @@ func (o *OltDevice) GetGemPortStatistics(ctx context.Context, in *openolt.OnuPac
 }
 
 func (o *OltDevice) GetOnuStatistics(ctx context.Context, in *openolt.Onu) (*openolt.OnuStatistics, error) {
-	return &openolt.OnuStatistics{}, nil
+	onu, err := o.FindOnuById(in.IntfId, in.OnuId)
+	if err != nil {
+		oltLogger.WithFields(log.Fields{
+			"OnuId":  in.OnuId,
+			"IntfId": in.IntfId,
+			"err":    err,
+		}).Error("Can't find Onu")
+		return nil, err
+	}
+
+	corrected := atomic.LoadUint64(&onu.FecCodewordsCorrected)
+	return &openolt.OnuStatistics{
+		IntfId:                    in.IntfId,
+		OnuId:                     in.OnuId,
+		FecCodewords:              atomic.LoadUint64(&onu.FecCodewords),
+		FecCodewordsCorrected:     corrected,
+		FecCorrectedUnits:         corrected,
+		FecCodewordsUncorrectable: atomic.LoadUint64(&onu.FecCodewordsUncorrectable),
+		Timestamp:                 uint32(deviceClock.Now().Unix()),
+	}, nil
+}
+
+// allocIdInPonRange checks an alloc-id carried by a flow against the pool
+// advertised in the PON configuration, a real OLT would refuse ids outside of it
+func (o *OltDevice) allocIdInPonRange(ponId uint32, allocId int32) bool {
+	ponConf, err := common.GetPonConfigById(ponId)
+	if err != nil {
+		// without a configuration for this PON there is no range to enforce
+		return true
+	}
+	return uint32(allocId) >= ponConf.AllocIdRange.StartId && uint32(allocId) <= ponConf.AllocIdRange.EndId
+}
+
+// gemPortInPonRange checks a gem-port-id carried by a flow against the pool
+// advertised in the PON configuration
+func (o *OltDevice) gemPortInPonRange(ponId uint32, gemId int32) bool {
+	ponConf, err := common.GetPonConfigById(ponId)
+	if err != nil {
+		return true
+	}
+	return uint32(gemId) >= ponConf.GemportRange.StartId && uint32(gemId) <= ponConf.GemportRange.EndId
 }
 
 func (o *OltDevice) storeAllocId(flow *openolt.Flow) {
+	if !o.allocIdInPonRange(uint32(flow.AccessIntfId), flow.AllocId) {
+		oltLogger.WithFields(log.Fields{
+			"IntfId":  flow.AccessIntfId,
+			"OnuId":   flow.OnuId,
+			"PortNo":  flow.PortNo,
+			"AllocId": flow.AllocId,
+			"FlowId":  flow.FlowId,
+		}).Error("rejecting-alloc-id-outside-of-configured-range")
+		return
+	}
+
 	o.AllocIDsLock.Lock()
 	defer o.AllocIDsLock.Unlock()
 
@@ -1838,6 +3125,17 @@ func (o *OltDevice) freeAllocId(flow *openolt.Flow) {
 }
 
 func (o *OltDevice) storeGemPortId(ponId uint32, onuId uint32, portNo uint32, gemId int32, flowId uint64) {
+	if !o.gemPortInPonRange(ponId, gemId) {
+		oltLogger.WithFields(log.Fields{
+			"IntfId":    ponId,
+			"OnuId":     onuId,
+			"PortNo":    portNo,
+			"GemportId": gemId,
+			"FlowId":    flowId,
+		}).Error("rejecting-gemport-outside-of-configured-range")
+		return
+	}
+
 	o.GemPortIDsLock.Lock()
 	defer o.GemPortIDsLock.Unlock()
 
@@ -1930,6 +3228,46 @@ func (o *OltDevice) freeGemPortId(flow *openolt.Flow) {
 	}
 }
 
+// kinds of resource a FlowConflict can be about
+const (
+	FlowConflictGemPort = "gemport"
+	FlowConflictAllocId = "alloc-id"
+)
+
+// FlowConflict describes a gem-port or alloc-id referenced by a new flow that
+// is already in use by another ONU/UNI on the same PON. It implements error so
+// validateFlow keeps returning it as one, while callers can inspect the fields
+// programmatically instead of parsing the message
+type FlowConflict struct {
+	Kind        string // FlowConflictGemPort or FlowConflictAllocId
+	Id          int32  // the conflicting gem-port or alloc id
+	OnuId       uint32 // the ONU already referencing the id
+	UniId       uint32 // the UNI (port-no) already referencing the id
+	FlowId      uint64 // the flow the conflict was detected for
+	OtherFlowId uint64 // one of the flows already referencing the id
+	Replicated  bool   // whether the conflicting flow is a replicated one
+}
+
+func (c FlowConflict) Error() string {
+	flow := "flow"
+	if c.Replicated {
+		flow = "replicated-flow"
+	}
+	kind := "gem"
+	if c.Kind == FlowConflictAllocId {
+		kind = "allocId"
+	}
+	return fmt.Sprintf("%s-%d-already-in-use-on-uni-%d-onu-%d-%s-%d", kind, c.Id, c.UniId, c.OnuId, flow, c.FlowId)
+}
+
+// anyFlowId returns one of the flow ids referencing a conflicting resource
+func anyFlowId(flows map[uint64]bool) uint64 {
+	for flowId := range flows {
+		return flowId
+	}
+	return 0
+}
+
 // validateFlow checks that:
 // - the AllocId is not used in any flow referencing other ONUs/UNIs on the same PON
 // - the GemPortId is not used in any flow referencing other ONUs/UNIs on the same PON
@@ -1942,16 +3280,31 @@ func (o *OltDevice) validateFlow(flow *openolt.Flow) error {
 			continue
 		}
 		for uniId, uni := range onu {
-			for gem := range uni {
+			for gem, flows := range uni {
 				if flow.ReplicateFlow {
 					for _, flowGem := range flow.PbitToGemport {
 						if gem == int32(flowGem) {
-							return fmt.Errorf("gem-%d-already-in-use-on-uni-%d-onu-%d-replicated-flow-%d", gem, uniId, onuId, flow.FlowId)
+							return FlowConflict{
+								Kind:        FlowConflictGemPort,
+								Id:          gem,
+								OnuId:       onuId,
+								UniId:       uniId,
+								FlowId:      flow.FlowId,
+								OtherFlowId: anyFlowId(flows),
+								Replicated:  true,
+							}
 						}
 					}
 				} else {
 					if gem == flow.GemportId {
-						return fmt.Errorf("gem-%d-already-in-use-on-uni-%d-onu-%d-flow-%d", gem, uniId, onuId, flow.FlowId)
+						return FlowConflict{
+							Kind:        FlowConflictGemPort,
+							Id:          gem,
+							OnuId:       onuId,
+							UniId:       uniId,
+							FlowId:      flow.FlowId,
+							OtherFlowId: anyFlowId(flows),
+						}
 					}
 				}
 			}
@@ -1965,9 +3318,16 @@ func (o *OltDevice) validateFlow(flow *openolt.Flow) error {
 			continue
 		}
 		for uniId, uni := range onu {
-			for allocId := range uni {
+			for allocId, flows := range uni {
 				if allocId == flow.AllocId {
-					return fmt.Errorf("allocId-%d-already-in-use-on-uni-%d-onu-%d-flow-%d", allocId, uniId, onuId, flow.FlowId)
+					return FlowConflict{
+						Kind:        FlowConflictAllocId,
+						Id:          allocId,
+						OnuId:       onuId,
+						UniId:       uniId,
+						FlowId:      flow.FlowId,
+						OtherFlowId: anyFlowId(flows),
+					}
 				}
 			}
 		}
@@ -1987,6 +3347,12 @@ func (o *OltDevice) clearAllResources() {
 	o.AllocIDsLock.Lock()
 	o.AllocIDs = make(map[uint32]map[uint32]map[uint32]map[int32]map[uint64]bool)
 	o.AllocIDsLock.Unlock()
+	o.mcastFlowsLock.Lock()
+	o.mcastFlows = make(map[uint64]*openolt.Flow)
+	o.mcastFlowsLock.Unlock()
+	o.gemPortEncryptionLock.Lock()
+	o.gemPortEncryption = make(map[uint32]map[uint32]bool)
+	o.gemPortEncryptionLock.Unlock()
 
 	// remove the resources received via OMCI
 	for _, pon := range o.Pons {
@@ -1996,14 +3362,290 @@ func (o *OltDevice) clearAllResources() {
 	}
 }
 
+// sendEvent publishes an event on EventChannel without blocking, if the
+// channel is full (e.g. the consumer died) the event is dropped and counted
+// so that the publisher can never stall OLT processing
+func (o *OltDevice) sendEvent(event common.Event) {
+	select {
+	case o.EventChannel <- event:
+	default:
+		atomic.AddUint64(&o.droppedEvents, 1)
+		oltLogger.WithFields(log.Fields{
+			"EventType":     event.EventType,
+			"DroppedEvents": atomic.LoadUint64(&o.droppedEvents),
+		}).Warn("Event channel is full, dropping event")
+	}
+}
+
+// DroppedEvents returns the number of events dropped because no consumer
+// was draining EventChannel
+func (o *OltDevice) DroppedEvents() uint64 {
+	return atomic.LoadUint64(&o.droppedEvents)
+}
+
+// SubscribeEvents registers a subscriber to the event stream, every
+// published event is fanned out to each registered channel
+func (o *OltDevice) SubscribeEvents() chan common.Event {
+	o.eventSubscribersLock.Lock()
+	defer o.eventSubscribersLock.Unlock()
+	if o.eventSubscribers == nil {
+		o.eventSubscribers = make(map[chan common.Event]struct{})
+	}
+	ch := make(chan common.Event, 100)
+	o.eventSubscribers[ch] = struct{}{}
+	return ch
+}
+
+// UnsubscribeEvents removes a subscriber registered via SubscribeEvents
+func (o *OltDevice) UnsubscribeEvents(ch chan common.Event) {
+	o.eventSubscribersLock.Lock()
+	defer o.eventSubscribersLock.Unlock()
+	delete(o.eventSubscribers, ch)
+}
+
+// notifyEventSubscribers delivers an event to every registered subscriber,
+// dropping it for subscribers that are not consuming fast enough
+func (o *OltDevice) notifyEventSubscribers(event common.Event) {
+	o.eventSubscribersLock.Lock()
+	defer o.eventSubscribersLock.Unlock()
+	for ch := range o.eventSubscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// bossValidateRequest enforces the error convention shared by the BOSS
+// endpoints: a missing device id is an InvalidArgument and provisioning is
+// a FailedPrecondition unless the OLT is enabled. Endpoints targeting a
+// specific ONU additionally map unknown ONUs to NotFound via bossFindOnu.
+func (o *OltDevice) bossValidateRequest(reqMessage *bossopenolt.BossRequest) error {
+	if reqMessage.GetDeviceId() == "" {
+		return status.Error(codes.InvalidArgument, "device-id-is-required")
+	}
+	if o.InternalState.Current() != OltInternalStateEnabled {
+		return status.Errorf(codes.FailedPrecondition, "olt-is-%s", o.InternalState.Current())
+	}
+	return nil
+}
+
+// bossFindOnu resolves the ONU targeted by a BOSS request, mapping unknown
+// ONUs to a NotFound status
+func (o *OltDevice) bossFindOnu(onuID int32) (*Onu, error) {
+	for _, pon := range o.Pons {
+		for _, onu := range pon.Onus {
+			if onu.ID == uint32(onuID) {
+				return onu, nil
+			}
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "cannot-find-onu-with-id-%d", onuID)
+}
+
+// bossMacConfig holds the MAC-layer settings provisioned via the BOSS
+// endpoints, reported back by GetDeviceMacInfo
+type bossMacConfig struct {
+	Mtu       int32
+	VlanMode  int32
+	AgingMode int32
+	AgingTime int32
+}
+
+// bossBurstConfig holds the burst-mode parameters provisioned via the BOSS
+// Set/GetBurst endpoints so a read-back returns what was configured. The
+// historical canned replies are kept as defaults for parameters never set
+type bossBurstConfig struct {
+	DelimiterLength int32
+	Delimiter       string
+	PreambleLength  int32
+	Preamble        string
+	PreambleRepeat  int32
+	Version         string
+	VersionIndex    int32
+	Pontag          int64
+}
+
+// factory defaults for the BOSS device-level settings, applied at creation
+// and restored on a hard reboot
+const (
+	defaultErrorPermit = 3
+	defaultFecDecMode  = 1
+)
+
+func defaultBossMacConfig() bossMacConfig {
+	return bossMacConfig{
+		Mtu:       1522,
+		VlanMode:  0,
+		AgingMode: 0,
+		AgingTime: 10,
+	}
+}
+
+func defaultBossBurstConfig() bossBurstConfig {
+	return bossBurstConfig{
+		DelimiterLength: 0,
+		Delimiter:       "0x00",
+		PreambleLength:  0,
+		Preamble:        "0x00",
+		PreambleRepeat:  80,
+		Version:         "1",
+		VersionIndex:    3,
+		Pontag:          0x00000000001,
+	}
+}
+
+// resetBossConfig restores the BOSS device-level configuration (and the
+// per-ONU provisioning hanging off it) to factory defaults. Invoked on a
+// hard reboot, which is expected to clear provisioning; a soft reboot
+// keeps it
+func (o *OltDevice) resetBossConfig() {
+	o.bossMu.Lock()
+	defer o.bossMu.Unlock()
+	o.bossMac = defaultBossMacConfig()
+	o.bossBurst = defaultBossBurstConfig()
+	o.errorPermit = defaultErrorPermit
+	o.fecDecMode = defaultFecDecMode
+	o.bossOnus = make(map[uint32]*bossOnuState)
+}
+
+// BossConfigSnapshot is a copy of every device-level setting provisioned via
+// the BOSS endpoints, taken under a single lock acquisition
+type BossConfigSnapshot struct {
+	Mac         bossMacConfig
+	Burst       bossBurstConfig
+	ErrorPermit int32
+	FecDecMode  int32
+	Slas        []*bossopenolt.AddOnuSla
+}
+
+// TakeBossConfigSnapshot collects the current BOSS device configuration in
+// one lock-consistent view, so a provisioning audit does not need to issue
+// dozens of individual Get calls
+func (o *OltDevice) TakeBossConfigSnapshot() BossConfigSnapshot {
+	o.bossMu.RLock()
+	defer o.bossMu.RUnlock()
+
+	snapshot := BossConfigSnapshot{
+		Mac:         o.bossMac,
+		Burst:       o.bossBurst,
+		ErrorPermit: o.errorPermit,
+		FecDecMode:  o.fecDecMode,
+	}
+	for _, state := range o.bossOnus {
+		snapshot.Slas = append(snapshot.Slas, state.Slas...)
+	}
+	// map iteration order is random, keep the audit output stable
+	sort.Slice(snapshot.Slas, func(i, j int) bool {
+		if snapshot.Slas[i].OnuId != snapshot.Slas[j].OnuId {
+			return snapshot.Slas[i].OnuId < snapshot.Slas[j].OnuId
+		}
+		return snapshot.Slas[i].Tcont < snapshot.Slas[j].Tcont
+	})
+	return snapshot
+}
+
+// bossOnuState holds the BOSS-side state provisioned for one ONU
+type bossOnuState struct {
+	Vssn     int32
+	AllocIds []int32
+	Slas     []*bossopenolt.AddOnuSla
+	SlasV2   []*bossopenolt.SetSlaV2
+}
+
+// ponCommittedBw sums the guaranteed (fixed plus assured) bandwidth in Mbps
+// of every SLA provisioned on a PON. The caller must hold bossMu
+func (o *OltDevice) ponCommittedBw(ponId uint32) int32 {
+	var committed int32
+	for onuId, state := range o.bossOnus {
+		onu, err := o.bossFindOnu(int32(onuId))
+		if err != nil || onu.PonPortID != ponId {
+			continue
+		}
+		for _, sla := range state.Slas {
+			committed += sla.Abmin
+		}
+		for _, sla := range state.SlasV2 {
+			committed += sla.Rf + sla.Ra
+		}
+	}
+	return committed
+}
+
+// ponDbaAdmits models the DBA admission control of a real OLT: an SLA asking
+// for extraBw Mbps of guaranteed bandwidth is admitted only while the total
+// committed on the PON stays within the configured dba_capacity_mbps.
+// A capacity of 0 (or a missing PON configuration) disables the check.
+// The caller must hold bossMu
+func (o *OltDevice) ponDbaAdmits(ponId uint32, extraBw int32) bool {
+	ponConf, err := common.GetPonConfigById(ponId)
+	if err != nil || ponConf.DbaCapacityMbps == 0 {
+		return true
+	}
+	committed := o.ponCommittedBw(ponId)
+	if committed+extraBw > int32(ponConf.DbaCapacityMbps) {
+		oltLogger.WithFields(log.Fields{
+			"IntfId":          ponId,
+			"CommittedBwMbps": committed,
+			"RequestedBwMbps": extraBw,
+			"CapacityMbps":    ponConf.DbaCapacityMbps,
+		}).Warn("rejecting-sla-pon-dba-capacity-exceeded")
+		return false
+	}
+	return true
+}
+
+// bossOnuStateFor returns the BOSS state for an ONU, creating it on first
+// use. The caller must hold bossMu
+func (o *OltDevice) bossOnuStateFor(onuId uint32) *bossOnuState {
+	if o.bossOnus == nil {
+		o.bossOnus = make(map[uint32]*bossOnuState)
+	}
+	state, ok := o.bossOnus[onuId]
+	if !ok {
+		state = &bossOnuState{}
+		o.bossOnus[onuId] = state
+	}
+	return state
+}
+
+// removeOnuState clears every piece of per-ONU state the OLT keeps outside
+// the ONU struct: the BOSS provisioning (VSSN, SLA entries, alloc-ids) and
+// the alloc/gem resource maps. Invoked from both the OpenOLT DeleteOnu and
+// the BOSS DeleteOnu25G paths so a re-added ONU starts clean
+func (o *OltDevice) removeOnuState(intfId uint32, onuId uint32) {
+	o.bossMu.Lock()
+	delete(o.bossOnus, onuId)
+	o.bossMu.Unlock()
+
+	o.AllocIDsLock.Lock()
+	if _, ok := o.AllocIDs[intfId]; ok {
+		delete(o.AllocIDs[intfId], onuId)
+	}
+	o.AllocIDsLock.Unlock()
+
+	o.GemPortIDsLock.Lock()
+	if _, ok := o.GemPortIDs[intfId]; ok {
+		delete(o.GemPortIDs[intfId], onuId)
+	}
+	o.GemPortIDsLock.Unlock()
+
+	oltLogger.WithFields(log.Fields{
+		"IntfId": intfId,
+		"OnuId":  onuId,
+	}).Debug("removed-per-onu-boss-state")
+}
+
 func (o *OltDevice) GetVlan(ctx context.Context, request *bossopenolt.BossRequest)(*bossopenolt.GetVlanResponse, error){
 	oltLogger.WithFields(log.Fields{
 		"request" : request,
 	}).Debug("GetVlann......")
 
+	o.bossMu.RLock()
+	defer o.bossMu.RUnlock()
 	resp := bossopenolt.GetVlanResponse{
 		DeviceId : request.DeviceId,
-		VlanMode : 0,
+		VlanMode : o.bossMac.VlanMode,
 		Fields : "0x3064",
 	}
 	return &resp, nil
@@ -2018,8 +3660,8 @@ func(o *OltDevice) GetOltConnect(ctx context.Context, reqMessage *bossopenolt.Bo
 
 	response := &bossopenolt.OltConnResponse{
 		DeviceId : reqMessage.DeviceId,
-		Ip : "192.168.0.1",
-		Mac : "00:AA:10:11:13:03",
+		Ip : common.Config.Olt.ManagementIp,
+		Mac : common.Config.Olt.ManagementMac,
 	}
 	//return response, nil
 	return response, nil
@@ -2033,11 +3675,11 @@ func(o *OltDevice) GetOltDeviceInfo(ctx context.Context, reqMessage *bossopenolt
 
 	response := &bossopenolt.OltDevResponse{
 		DeviceId : reqMessage.DeviceId,
-		FpgaType : "25G OLT",
-		FpgaVer  : "1.0",
-		Fpga_Date : "2020.09.02",
-		SwVer : "1.0",
-		SwDate : "2020.06.30",
+		FpgaType : common.Config.Olt.FpgaType,
+		FpgaVer  : common.Config.Olt.FpgaVersion,
+		Fpga_Date : common.Config.Olt.FpgaDate,
+		SwVer : common.Config.Olt.SwVersion,
+		SwDate : common.Config.Olt.SwDate,
 	}
 	//return response, nil
 	return response, nil
@@ -2128,11 +3770,13 @@ func(o *OltDevice) PortReset(ctx context.Context, reqMessage *bossopenolt.BossRe
 	return response, nil
 }
 func(o *OltDevice) SetMtuSize(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ExecResult, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
+	if err := o.bossValidateRequest(reqMessage); err != nil {
+		return nil, err
+	}
+
+	o.bossMu.Lock()
+	o.bossMac.Mtu = reqMessage.GetParam().GetSetmtusizeParam().GetMtuSize()
+	o.bossMu.Unlock()
 
 	response := &bossopenolt.ExecResult{
 		Result : 0,
@@ -2141,24 +3785,18 @@ func(o *OltDevice) SetMtuSize(ctx context.Context, reqMessage *bossopenolt.BossR
 	return response, nil
 }
 func(o *OltDevice) GetMtuSize(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.MtuSizeResponse, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
-
+	o.bossMu.RLock()
+	defer o.bossMu.RUnlock()
 	response := &bossopenolt.MtuSizeResponse{
-		Mtu : 1,
+		Mtu : o.bossMac.Mtu,
 	}
 	//return response, nil
 	return response, nil
 }
 func(o *OltDevice) SetVlan(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ExecResult, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
+	o.bossMu.Lock()
+	o.bossMac.VlanMode = reqMessage.GetParam().GetSetvlanParam().GetAction()
+	o.bossMu.Unlock()
 
 	response := &bossopenolt.ExecResult{
 		Result : 0,
@@ -2194,11 +3832,9 @@ func(o *OltDevice) GetLutMode(ctx context.Context, reqMessage *bossopenolt.BossR
 	return response, nil
 }
 func(o *OltDevice) SetAgingMode(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ExecResult, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
+	o.bossMu.Lock()
+	o.bossMac.AgingMode = reqMessage.GetParam().GetIntegervalueParam().GetValue()
+	o.bossMu.Unlock()
 
 	response := &bossopenolt.ExecResult{
 		Result : 0,
@@ -2207,25 +3843,19 @@ func(o *OltDevice) SetAgingMode(ctx context.Context, reqMessage *bossopenolt.Bos
 	return response, nil
 }
 func(o *OltDevice) GetAgingMode(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ModeResponse, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
-
+	o.bossMu.RLock()
+	defer o.bossMu.RUnlock()
 	response := &bossopenolt.ModeResponse{
 		DeviceId : reqMessage.DeviceId,
-		Mode : 0,
+		Mode : o.bossMac.AgingMode,
 	}
 	//return response, nil
 	return response, nil
 }
 func(o *OltDevice) SetAgingTime(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ExecResult, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
+	o.bossMu.Lock()
+	o.bossMac.AgingTime = reqMessage.GetParam().GetIntegervalueParam().GetValue()
+	o.bossMu.Unlock()
 
 	response := &bossopenolt.ExecResult{
 		Result : 0,
@@ -2239,9 +3869,11 @@ func(o *OltDevice) GetAgingTime(ctx context.Context, reqMessage *bossopenolt.Bos
 		VlanMode : 1,
 		Fields : "0x3064",
 	}*/
+	o.bossMu.RLock()
+	defer o.bossMu.RUnlock()
 	response := &bossopenolt.AgingTimeResponse{
 		DeviceId : reqMessage.DeviceId,
-		AgingTime : 0,
+		AgingTime : o.bossMac.AgingTime,
 	}
 	//return response, nil
 	return response, nil
@@ -2252,12 +3884,19 @@ func(o *OltDevice) GetDeviceMacInfo(ctx context.Context, reqMessage *bossopenolt
 		VlanMode : 1,
 		Fields : "0x3064",
 	}*/
+	if err := o.bossValidateRequest(reqMessage); err != nil {
+		return nil, err
+	}
+
+	// aggregate the MAC-layer settings stored by the Set* endpoints
+	o.bossMu.RLock()
+	defer o.bossMu.RUnlock()
 	response := &bossopenolt.DevMacInfoResponse{
 		DeviceId : reqMessage.DeviceId,
-		Mtu : 1522,
-		VlanMode : 0,
-		AgingMode : 0,
-		AgingTime : 10,
+		Mtu : o.bossMac.Mtu,
+		VlanMode : o.bossMac.VlanMode,
+		AgingMode : o.bossMac.AgingMode,
+		AgingTime : o.bossMac.AgingTime,
 	}
 	//return response, nil
 	return response, nil
@@ -2378,75 +4017,133 @@ func(o *OltDevice) AddOnu(ctx context.Context, reqMessage *bossopenolt.BossReque
 		Fields : "0x3064",
 	}*/
 
+	vendorId := defaultOnuVendorId
+	if onu, err := o.bossFindOnu(reqMessage.GetParam().GetOnuctrlParam().OnuId); err == nil {
+		vendorId = onu.vendorId()
+	}
+
 	response := &bossopenolt.AddOnuResponse{
 		DeviceId : reqMessage.DeviceId,
 		OnuId : reqMessage.GetParam().GetOnuctrlParam().OnuId,
 		Result : "success",
 		Rate : "25G",
-		VendorId : "747421",
+		VendorId : vendorId,
 		Vssn : "10111001",
 	}
 	//return response, nil
 	return response, nil
 }
 func(o *OltDevice) DeleteOnu25G(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ExecResult, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
+	if param := reqMessage.GetParam().GetOnuctrlParam(); param != nil {
+		if onu, err := o.bossFindOnu(param.OnuId); err == nil {
+			o.removeOnuState(onu.PonPortID, onu.ID)
+		} else {
+			// still drop whatever BOSS state was keyed to the id
+			o.bossMu.Lock()
+			delete(o.bossOnus, uint32(param.OnuId))
+			o.bossMu.Unlock()
+		}
+	}
 
 	response := &bossopenolt.ExecResult{
 		Result : 0,
 	}
-	//return response, nil
 	return response, nil
 }
 func(o *OltDevice) AddOnuSla(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ExecResult, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
+	if param := reqMessage.GetParam().GetAddonuslaParam(); param != nil {
+		o.bossMu.Lock()
+		state := o.bossOnuStateFor(uint32(param.OnuId))
+		// one SLA per T-CONT, a second add replaces the existing entry and
+		// only commits the difference in assured bandwidth
+		replaceIdx := -1
+		extraBw := param.Abmin
+		for i, sla := range state.Slas {
+			if sla.Tcont == param.Tcont {
+				replaceIdx = i
+				extraBw -= sla.Abmin
+				break
+			}
+		}
+		if onu, err := o.bossFindOnu(param.OnuId); err == nil && !o.ponDbaAdmits(onu.PonPortID, extraBw) {
+			o.bossMu.Unlock()
+			return &bossopenolt.ExecResult{Result: 1}, nil
+		}
+		if replaceIdx >= 0 {
+			state.Slas[replaceIdx] = param
+		} else {
+			state.Slas = append(state.Slas, param)
+		}
+		o.bossMu.Unlock()
+	}
 
 	response := &bossopenolt.ExecResult{
 		Result : 0,
 	}
-	//return response, nil
 	return response, nil
 }
 func(o *OltDevice) ClearOnuSla(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ExecResult, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
+	if param := reqMessage.GetParam().GetClearonuslaParam(); param != nil {
+		o.bossMu.Lock()
+		if state, ok := o.bossOnus[uint32(param.OnuId)]; ok {
+			for i, sla := range state.Slas {
+				if sla.Tcont == param.Tcont {
+					state.Slas = append(state.Slas[:i], state.Slas[i+1:]...)
+					break
+				}
+			}
+		}
+		o.bossMu.Unlock()
+	}
 
 	response := &bossopenolt.ExecResult{
 		Result : 0,
 	}
-	//return response, nil
 	return response, nil
 }
 func(o *OltDevice) GetSlaTable(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.RepeatedSlaResponse, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
-	item := &bossopenolt.SlaResponse{
-		DeviceId : reqMessage.DeviceId,
-		OnuId : 0,
-		Tcont : 0,
-		Type : "SBDBA",
-		Si : 1,
-		Abmin :2,
-		Absur : 1,
-		Fec : "On",
-		Distance : 1,
+	// optional ONU-id/T-CONT filter: values above zero narrow the table,
+	// a missing param (or zeroes) returns every provisioned SLA
+	filterOnu := int32(0)
+	filterTcont := int32(0)
+	if param := reqMessage.GetParam().GetClearonuslaParam(); param != nil {
+		filterOnu = param.OnuId
+		filterTcont = param.Tcont
 	}
+
 	items:=[]*bossopenolt.SlaResponse{}
-	items = append(items, item)
+	o.bossMu.RLock()
+	for onuId, state := range o.bossOnus {
+		if filterOnu > 0 && int32(onuId) != filterOnu {
+			continue
+		}
+		for _, sla := range state.Slas {
+			if filterTcont > 0 && sla.Tcont != filterTcont {
+				continue
+			}
+			items = append(items, &bossopenolt.SlaResponse{
+				DeviceId : reqMessage.DeviceId,
+				OnuId : sla.OnuId,
+				Tcont : sla.Tcont,
+				Type : "SBDBA",
+				Si : sla.Si,
+				Abmin : sla.Abmin,
+				Absur : sla.Absur,
+				Fec : "On",
+				Distance : 1,
+			})
+		}
+	}
+	o.bossMu.RUnlock()
+
+	// the ONU map iterates in random order, keep the table stable
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].OnuId != items[j].OnuId {
+			return items[i].OnuId < items[j].OnuId
+		}
+		return items[i].Tcont < items[j].Tcont
+	})
+
 	response := &bossopenolt.RepeatedSlaResponse{
 		Resp : items,
 	}
@@ -2455,57 +4152,75 @@ func(o *OltDevice) GetSlaTable(ctx context.Context, reqMessage *bossopenolt.Boss
 	return response, nil
 }
 func(o *OltDevice) SetOnuAllocid(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ExecResult, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
+	if param := reqMessage.GetParam().GetSetonuallocidParam(); param != nil {
+		o.bossMu.Lock()
+		state := o.bossOnuStateFor(uint32(param.OnuId))
+		found := false
+		for _, id := range state.AllocIds {
+			if id == param.AllocId {
+				found = true
+				break
+			}
+		}
+		if !found {
+			state.AllocIds = append(state.AllocIds, param.AllocId)
+		}
+		o.bossMu.Unlock()
+	}
 
 	response := &bossopenolt.ExecResult{
 		Result : 0,
 	}
-	//return response, nil
 	return response, nil
 }
 func(o *OltDevice) DelOnuAllocid(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ExecResult, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
+	if param := reqMessage.GetParam().GetSetonuallocidParam(); param != nil {
+		o.bossMu.Lock()
+		if state, ok := o.bossOnus[uint32(param.OnuId)]; ok {
+			for i, id := range state.AllocIds {
+				if id == param.AllocId {
+					state.AllocIds = append(state.AllocIds[:i], state.AllocIds[i+1:]...)
+					break
+				}
+			}
+		}
+		o.bossMu.Unlock()
+	}
 
 	response := &bossopenolt.ExecResult{
 		Result : 0,
 	}
-	//return response, nil
 	return response, nil
 }
 func(o *OltDevice) SetOnuVssn(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ExecResult, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
+	if param := reqMessage.GetParam().GetSetonuvssnParam(); param != nil {
+		o.bossMu.Lock()
+		o.bossOnuStateFor(uint32(param.OnuId)).Vssn = param.Vssn
+		o.bossMu.Unlock()
+	}
 
 	response := &bossopenolt.ExecResult{
 		Result : 0,
 	}
-	//return response, nil
 	return response, nil
 }
 func(o *OltDevice) GetOnuVssn(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.OnuVssnResponse, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
+	onuId := reqMessage.GetParam().GetOnuctrlParam().OnuId
+
+	// report the provisioned VSSN, keeping the historical canned value for
+	// ONUs that were never provisioned
+	vssn := int32(0x123)
+	o.bossMu.RLock()
+	if state, ok := o.bossOnus[uint32(onuId)]; ok && state.Vssn != 0 {
+		vssn = state.Vssn
+	}
+	o.bossMu.RUnlock()
 
 	response := &bossopenolt.OnuVssnResponse{
 		DeviceId : reqMessage.DeviceId,
-		OnuId : reqMessage.GetParam().GetOnuctrlParam().OnuId,
-		Vssn : 0x123,
+		OnuId : onuId,
+		Vssn : vssn,
 	}
-	//return response, nil
 	return response, nil
 }
 func(o *OltDevice) GetOnuDistance(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.OnuDistResponse, error){
@@ -2515,20 +4230,29 @@ func(o *OltDevice) GetOnuDistance(ctx context.Context, reqMessage *bossopenolt.B
 		Fields : "0x3064",
 	}*/
 
+	if err := o.bossValidateRequest(reqMessage); err != nil {
+		return nil, err
+	}
+	onu, err := o.bossFindOnu(reqMessage.GetParam().GetOnuctrlParam().OnuId)
+	if err != nil {
+		return nil, err
+	}
+
 	response := &bossopenolt.OnuDistResponse{
 		DeviceId : reqMessage.DeviceId,
-		OnuId : reqMessage.GetParam().GetOnuctrlParam().OnuId,
-		Distance : 1,
+		OnuId : int32(onu.ID),
+		Distance : int32(onu.DistanceKm),
 	}
 	//return response, nil
 	return response, nil
 }
 func(o *OltDevice) SetBurstDelimiter(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ExecResult, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
+	if param := reqMessage.GetParam().GetSetburstdelimitParam(); param != nil {
+		o.bossMu.Lock()
+		o.bossBurst.DelimiterLength = param.Length
+		o.bossBurst.Delimiter = param.Delimiter
+		o.bossMu.Unlock()
+	}
 
 	response := &bossopenolt.ExecResult{
 		Result : 0,
@@ -2537,26 +4261,26 @@ func(o *OltDevice) SetBurstDelimiter(ctx context.Context, reqMessage *bossopenol
 	return response, nil
 }
 func(o *OltDevice) GetBurstDelimiter(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.BurstDelimitResponse, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
-
+	// report the provisioned delimiter, CreateOLT seeds the historical
+	// canned values as defaults
+	o.bossMu.RLock()
 	response := &bossopenolt.BurstDelimitResponse{
 		DeviceId : reqMessage.DeviceId,
-		Length: 0,
-		Delimiter : "0x00",
+		Length: o.bossBurst.DelimiterLength,
+		Delimiter : o.bossBurst.Delimiter,
 	}
+	o.bossMu.RUnlock()
 	//return response, nil
 	return response, nil
 }
 func(o *OltDevice) SetBurstPreamble(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ExecResult, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
+	// the set request only carries the repeat count, length and pattern
+	// keep their defaults
+	if param := reqMessage.GetParam().GetSetburstpreambleParam(); param != nil {
+		o.bossMu.Lock()
+		o.bossBurst.PreambleRepeat = param.Repeat
+		o.bossMu.Unlock()
+	}
 
 	response := &bossopenolt.ExecResult{
 		Result : 0,
@@ -2565,27 +4289,25 @@ func(o *OltDevice) SetBurstPreamble(ctx context.Context, reqMessage *bossopenolt
 	return response, nil
 }
 func(o *OltDevice) GetBurstPreamble(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.BurstPreambleResponse, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
-
+	o.bossMu.RLock()
 	response := &bossopenolt.BurstPreambleResponse{
 		DeviceId : reqMessage.DeviceId,
-		Length: 0,
-		Preamble : "0x00",
-		Repeat : 80,
+		Length: o.bossBurst.PreambleLength,
+		Preamble : o.bossBurst.Preamble,
+		Repeat : o.bossBurst.PreambleRepeat,
 	}
+	o.bossMu.RUnlock()
 	//return response, nil
 	return response, nil
 }
 func(o *OltDevice) SetBurstVersion(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ExecResult, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
+	if param := reqMessage.GetParam().GetSetburstversionParam(); param != nil {
+		o.bossMu.Lock()
+		o.bossBurst.Version = param.Version
+		o.bossBurst.VersionIndex = param.Index
+		o.bossBurst.Pontag = param.Pontag
+		o.bossMu.Unlock()
+	}
 
 	response := &bossopenolt.ExecResult{
 		Result : 0,
@@ -2594,18 +4316,14 @@ func(o *OltDevice) SetBurstVersion(ctx context.Context, reqMessage *bossopenolt.
 	return response, nil
 }
 func(o *OltDevice) GetBurstVersion(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.BurstVersionResponse, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
-
+	o.bossMu.RLock()
 	response := &bossopenolt.BurstVersionResponse{
 		DeviceId : reqMessage.DeviceId,
-		Version: "1",
-		Index : 3,
-		Pontag : 0x00000000001,
+		Version: o.bossBurst.Version,
+		Index : o.bossBurst.VersionIndex,
+		Pontag : o.bossBurst.Pontag,
 	}
+	o.bossMu.RUnlock()
 	//return response, nil
 	return response, nil
 }
@@ -2666,13 +4384,20 @@ func(o *OltDevice) GetOnuInfo(ctx context.Context, reqMessage *bossopenolt.BossR
 		Fields : "0x3064",
 	}*/
 
+	vendorId := defaultOnuVendorId
+	distance := int32(defaultDistanceKm)
+	if onu, err := o.bossFindOnu(reqMessage.GetParam().GetOnuctrlParam().OnuId); err == nil {
+		vendorId = onu.vendorId()
+		distance = int32(onu.DistanceKm)
+	}
+
 	response := &bossopenolt.OnuInfoResponse{
 		DeviceId : reqMessage.DeviceId,
 		OnuId: reqMessage.GetParam().GetOnuctrlParam().OnuId,
 		Rate : "25G",
-		VendorId : "ETRI",
+		VendorId : vendorId,
 		Vssn : "00000001",
-		Distance : 1,
+		Distance : distance,
 		Status : "Running",
 	}
 	//return response, nil
@@ -2706,11 +4431,14 @@ func(o *OltDevice) SetDsOmciOnu(ctx context.Context, reqMessage *bossopenolt.Bos
 	return response, nil
 }
 func(o *OltDevice) SetDsOmciData(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ExecResult, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
+	// every downstream OMCI write produces upstream acknowledgement bytes
+	// that can be drained via GetUsOmciData
+	dsData := []byte(reqMessage.GetParam().GetSetdsomcidataParam().GetData())
+	usData := make([]int32, 0, len(dsData))
+	for _, b := range dsData {
+		usData = append(usData, int32(b))
+	}
+	o.queueUsOmciData(usData...)
 
 	response := &bossopenolt.ExecResult{
 		Result : 0,
@@ -2718,19 +4446,37 @@ func(o *OltDevice) SetDsOmciData(ctx context.Context, reqMessage *bossopenolt.Bo
 	//return response, nil
 	return response, nil
 }
+
+// Control value returned by GetUsOmciData when the upstream OMCI queue is
+// empty, otherwise Control carries the number of bytes still queued
+const usOmciQueueEmpty int32 = -1
+
+// queueUsOmciData appends upstream OMCI bytes for retrieval via GetUsOmciData
+func (o *OltDevice) queueUsOmciData(data ...int32) {
+	o.usOmciLock.Lock()
+	defer o.usOmciLock.Unlock()
+	o.usOmciQueue = append(o.usOmciQueue, data...)
+}
+
 func(o *OltDevice) GetUsOmciData(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.OmciDataResponse, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
+	o.usOmciLock.Lock()
+	defer o.usOmciLock.Unlock()
+
+	if len(o.usOmciQueue) == 0 {
+		return &bossopenolt.OmciDataResponse{
+			DeviceId: reqMessage.DeviceId,
+			Control : usOmciQueueEmpty,
+		}, nil
+	}
 
+	data := o.usOmciQueue[0]
+	o.usOmciQueue = o.usOmciQueue[1:]
+	// Control reports the bytes still queued so a client can poll until 0
 	response := &bossopenolt.OmciDataResponse{
 		DeviceId: reqMessage.DeviceId,
-		Control : 0x06,
-		Data : 0x08,
+		Control : int32(len(o.usOmciQueue)),
+		Data : data,
 	}
-	//return response, nil
 	return response, nil
 }
 func(o *OltDevice) SetTod(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ExecResult, error){
@@ -2746,6 +4492,22 @@ func(o *OltDevice) SetTod(ctx context.Context, reqMessage *bossopenolt.BossReque
 	//return response, nil
 	return response, nil
 }
+// AdvanceTod jumps the simulated time-of-day forward so ToD-sync logic can
+// be tested without real waiting
+func (o *OltDevice) AdvanceTod(d time.Duration) {
+	atomic.AddInt64(&o.todOffsetNs, int64(d))
+	oltLogger.WithFields(log.Fields{
+		"Advance": d,
+		"Offset":  time.Duration(atomic.LoadInt64(&o.todOffsetNs)),
+	}).Info("Advanced simulated time-of-day")
+}
+
+// todNow is the current simulated time-of-day, the clock plus any offset
+// accumulated through AdvanceTod
+func (o *OltDevice) todNow() time.Time {
+	return deviceClock.Now().Add(time.Duration(atomic.LoadInt64(&o.todOffsetNs)))
+}
+
 func(o *OltDevice) GetTod(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.TodResponse, error){
 	/*response :=&bossopenolt.GetVlanResponse{
 		DeviceId : reqMessage.DeviceId,
@@ -2756,7 +4518,7 @@ func(o *OltDevice) GetTod(ctx context.Context, reqMessage *bossopenolt.BossReque
 	response := &bossopenolt.TodResponse{
 		DeviceId: reqMessage.DeviceId,
 		Mode : 0,
-		Time : 10,
+		Time : int32(o.todNow().Unix()),
 	}
 	//return response, nil
 	return response, nil
@@ -2789,11 +4551,9 @@ func(o *OltDevice) GetDataMode(ctx context.Context, reqMessage *bossopenolt.Boss
 	return response, nil
 }
 func(o *OltDevice) SetFecDecMode(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ExecResult, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
+	o.bossMu.Lock()
+	o.fecDecMode = reqMessage.GetParam().GetIntegervalueParam().GetValue()
+	o.bossMu.Unlock()
 
 	response := &bossopenolt.ExecResult{
 		Result : 0,
@@ -2802,15 +4562,11 @@ func(o *OltDevice) SetFecDecMode(ctx context.Context, reqMessage *bossopenolt.Bo
 	return response, nil
 }
 func(o *OltDevice) GetFecDecMode(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ModeResponse, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
-
+	o.bossMu.RLock()
+	defer o.bossMu.RUnlock()
 	response := &bossopenolt.ModeResponse{
 		DeviceId: reqMessage.DeviceId,
-		Mode : 0,
+		Mode : o.fecDecMode,
 	}
 	//return response, nil
 	return response, nil
@@ -2843,11 +4599,9 @@ func(o *OltDevice) GetDelimiter(ctx context.Context, reqMessage *bossopenolt.Bos
 	return response, nil
 }
 func(o *OltDevice) SetErrorPermit(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ExecResult, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
+	o.bossMu.Lock()
+	o.errorPermit = reqMessage.GetParam().GetIntegervalueParam().GetValue()
+	o.bossMu.Unlock()
 
 	response := &bossopenolt.ExecResult{
 		Result : 0,
@@ -2856,19 +4610,29 @@ func(o *OltDevice) SetErrorPermit(ctx context.Context, reqMessage *bossopenolt.B
 	return response, nil
 }
 func(o *OltDevice) GetErrorPermit(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ErrorPermitResponse, error){
-	/*response :=&bossopenolt.GetVlanResponse{
-		DeviceId : reqMessage.DeviceId,
-		VlanMode : 1,
-		Fields : "0x3064",
-	}*/
-
+	o.bossMu.RLock()
+	defer o.bossMu.RUnlock()
 	response := &bossopenolt.ErrorPermitResponse{
 		DeviceId: reqMessage.DeviceId,
-		Value : 3,
+		Value : o.errorPermit,
 	}
 	//return response, nil
 	return response, nil
 }
+
+// getErrorPermit returns the provisioned FEC error-permit threshold
+func (o *OltDevice) getErrorPermit() int32 {
+	o.bossMu.RLock()
+	defer o.bossMu.RUnlock()
+	return o.errorPermit
+}
+
+// getFecDecMode returns the provisioned FEC decode mode
+func (o *OltDevice) getFecDecMode() int32 {
+	o.bossMu.RLock()
+	defer o.bossMu.RUnlock()
+	return o.fecDecMode
+}
 func(o *OltDevice) SetPmControl(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ExecResult, error){
 	/*response :=&bossopenolt.GetVlanResponse{
 		DeviceId : reqMessage.DeviceId,
@@ -2979,6 +4743,31 @@ func(o *OltDevice) SetSlaV2(ctx context.Context, reqMessage *bossopenolt.BossReq
                 VlanMode : 1,
                 Fields : "0x3064",
         }*/
+	if param := reqMessage.GetParam().GetSetslav2Param(); param != nil {
+		o.bossMu.Lock()
+		state := o.bossOnuStateFor(uint32(param.OnuId))
+		// one SLA per T-CONT, a second set replaces the existing entry and
+		// only commits the difference in guaranteed bandwidth
+		replaceIdx := -1
+		extraBw := param.Rf + param.Ra
+		for i, sla := range state.SlasV2 {
+			if sla.Tcont == param.Tcont {
+				replaceIdx = i
+				extraBw -= sla.Rf + sla.Ra
+				break
+			}
+		}
+		if !o.ponDbaAdmits(uint32(param.PonPort), extraBw) {
+			o.bossMu.Unlock()
+			return nil, status.Errorf(codes.ResourceExhausted, "pon-%d-dba-capacity-exceeded", param.PonPort)
+		}
+		if replaceIdx >= 0 {
+			state.SlasV2[replaceIdx] = param
+		} else {
+			state.SlasV2 = append(state.SlasV2, param)
+		}
+		o.bossMu.Unlock()
+	}
 
    response := &bossopenolt.SlaV2Response{
 		DeviceId: reqMessage.DeviceId,
@@ -3052,20 +4841,30 @@ func(o *OltDevice) GetPktInd(ctx context.Context, reqMessage *bossopenolt.BossRe
                 VlanMode : 1,
                 Fields : "0x3064",
         }*/
+	if err := o.bossValidateRequest(reqMessage); err != nil {
+		return nil, err
+	}
+	// the counter is reset on every query so the caller gets the
+	// packet-in volume since the last call
+	count := common.GetAndResetPktIndCount()
+	oltLogger.WithFields(log.Fields{
+		"DeviceId": reqMessage.DeviceId,
+		"PktIndCount": count,
+	}).Debug("received-get-pkt-ind")
    response := &bossopenolt.BossPktIndResponse{
 		DeviceId: reqMessage.DeviceId,
-    Result : "success",
+    Result : fmt.Sprintf("%d", count),
 	}
         //return response, nil
         return response, nil
 }
 
 func(o *OltDevice) SetLatencyClear(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.BossLatencyClearResponse, error){
-        /*response :=&bossopenolt.GetVlanResponse{
-                DeviceId : reqMessage.DeviceId,
-                VlanMode : 1,
-                Fields : "0x3064",
-        }*/
+   o.bossMu.Lock()
+   o.latencyFlow = nil
+   o.latencyMeasure = 0
+   o.bossMu.Unlock()
+   latencyFlag = false
    response := &bossopenolt.BossLatencyClearResponse{
 		DeviceId: reqMessage.DeviceId,
     Pon : 0,
@@ -3075,30 +4874,40 @@ func(o *OltDevice) SetLatencyClear(ctx context.Context, reqMessage *bossopenolt.
         return response, nil
 }
 func(o *OltDevice) SetLatencyFlow(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.BossLatencyFlowResponse, error){
-        /*response :=&bossopenolt.GetVlanResponse{
-                DeviceId : reqMessage.DeviceId,
-                VlanMode : 1,
-                Fields : "0x3064",
-        }*/
+   flow := reqMessage.GetParam().GetLatencyflowParam()
+   if flow == nil {
+     return nil, fmt.Errorf("missing-latencyflow-param")
+   }
+   o.bossMu.Lock()
+   o.latencyFlow = flow
+   // derive the measurement from the armed flow and the per-port BIP error
+   // injection, so it stays constant across reads until the flow is cleared
+   o.latencyMeasure = 1000 + flow.Pon*37 + flow.XgemId%1000
+   if errors, ok := o.injectedBipErrorsFor(uint32(flow.Pon)); ok {
+     o.latencyMeasure += int32(errors % 1000)
+   }
+   o.bossMu.Unlock()
+   latencyFlag = true
    response := &bossopenolt.BossLatencyFlowResponse{
 		DeviceId: reqMessage.DeviceId,
-    Pon : 0,
-    XgemId : 0,
+    Pon : flow.Pon,
+    XgemId : flow.XgemId,
 	}
         //return response, nil
         return response, nil
 }
 func(o *OltDevice) GetLatencyFlow(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.BossLatencyFlowResponse, error){
-        /*response :=&bossopenolt.GetVlanResponse{
-                DeviceId : reqMessage.DeviceId,
-                VlanMode : 1,
-                Fields : "0x3064",
-        }*/
+   o.bossMu.RLock()
+   defer o.bossMu.RUnlock()
    response := &bossopenolt.BossLatencyFlowResponse{
 		DeviceId: reqMessage.DeviceId,
     Pon : 0,
     XgemId : 0,
 	}
+   if o.latencyFlow != nil {
+     response.Pon = o.latencyFlow.Pon
+     response.XgemId = o.latencyFlow.XgemId
+   }
         //return response, nil
         return response, nil
 }
@@ -3120,25 +4929,49 @@ func(o *OltDevice) GetLatencyData(ctx context.Context, reqMessage *bossopenolt.B
         return response, nil
 }
 func(o *OltDevice) GetLatencyMeasure(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.BossLatencyMeasureResponse, error){
-        /*response :=&bossopenolt.GetVlanResponse{
-                DeviceId : reqMessage.DeviceId,
-                VlanMode : 1,
-                Fields : "0x3064",
-        }*/
+   o.bossMu.RLock()
+   defer o.bossMu.RUnlock()
+   // a measurement only makes sense once a flow was armed via SetLatencyFlow
+   if o.latencyFlow == nil {
+     return nil, fmt.Errorf("no-latency-flow-armed")
+   }
    response := &bossopenolt.BossLatencyMeasureResponse{
 		DeviceId: reqMessage.DeviceId,
-    Pon : 0,
-    Measure :0,
+    Pon : o.latencyFlow.Pon,
+    Measure : o.latencyMeasure,
 	}
         //return response, nil
         return response, nil
 }
 func(o *OltDevice) GetPortStats(ctx context.Context, reqMessage *bossopenolt.BossRequest) (*bossopenolt.ExecResult, error){
-        /*response :=&bossopenolt.GetVlanResponse{
-                DeviceId : reqMessage.DeviceId,
-                VlanMode : 1,
-                Fields : "0x3064",
-        }*/
+   // the response proto only carries a result code, so we push the current
+   // counters as a PortStats indication and report success/failure here
+   param := reqMessage.GetParam().GetGetportstatsParam()
+   if param == nil {
+     return &bossopenolt.ExecResult{Result: 1}, nil
+   }
+
+   valid := false
+   switch param.PortType {
+   case "nni":
+     valid = o.HasNni(uint32(param.IntfId))
+   case "pon":
+     valid = o.HasPon(uint32(param.IntfId))
+   }
+   if !valid {
+     oltLogger.WithFields(log.Fields{
+       "PortType": param.PortType,
+       "IntfId": param.IntfId,
+     }).Error("GetPortStats request for unknown port")
+     return &bossopenolt.ExecResult{Result: 1}, nil
+   }
+
+   if o.OpenoltStream != nil && len(o.OltStats) > 0 {
+     sendStat := o.OltStats[o.oltStatsIndex]
+     sendStat.IntfId = InterfaceIDToPortNo(uint32(param.IntfId), param.PortType)
+     o.send25GPortStatsIndication(&sendStat, o.OpenoltStream)
+   }
+
    response := &bossopenolt.ExecResult{
     Result :0,
 	}
@@ -18,16 +18,24 @@ package devices
 
 import (
 	"context"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"net"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
 	"github.com/looplab/fsm"
 	"github.com/opencord/bbsim/internal/bbsim/types"
 	bbsim "github.com/opencord/bbsim/internal/bbsim/types"
 	"github.com/opencord/bbsim/internal/common"
+	"github.com/opencord/voltha-protos/v5/go/bossopenolt"
 	"github.com/opencord/voltha-protos/v5/go/openolt"
+	"github.com/opencord/voltha-protos/v5/go/tech_profile"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -126,7 +134,8 @@ func TestCreateOLT(t *testing.T) {
 		},
 	}
 
-	olt := CreateOLT(*common.Config, common.Services, true)
+	olt, err := CreateOLT(*common.Config, common.Services, true)
+	assert.NoError(t, err)
 
 	assert.Equal(t, len(olt.Pons), int(common.Config.Olt.PonPorts))
 
@@ -189,6 +198,94 @@ func TestCreateOLT(t *testing.T) {
 	assert.Equal(t, olt.Pons[1].Onus[1].ID, uint32(2))
 }
 
+func TestCreateOLTBadConfig(t *testing.T) {
+
+	common.Services = []common.ServiceYaml{
+		{Name: "hsia", CTag: 900, CTagAllocation: common.TagAllocationUnique.String(), STag: 900, STagAllocation: common.TagAllocationShared.String()},
+	}
+
+	common.Config = &common.GlobalConfig{
+		Olt: common.OltConfig{
+			ID:          1,
+			PonPorts:    2,
+			OnusPonPort: 4,
+			UniPorts:    4,
+		},
+	}
+
+	// no PON configuration at all
+	common.PonsConfig = nil
+	_, err := CreateOLT(*common.Config, common.Services, true)
+	assert.Error(t, err)
+
+	// an ONU range smaller than the number of ONUs per PON
+	common.PonsConfig = &common.PonPortsConfig{
+		Number: common.Config.Olt.PonPorts,
+		Ranges: []common.PonRangeConfig{
+			{
+				PonRange:     common.IdRange{StartId: 0, EndId: common.Config.Olt.PonPorts - 1},
+				Technology:   common.XGSPON.String(),
+				OnuRange:     common.IdRange{StartId: 1, EndId: 2},
+				AllocIdRange: common.IdRange{StartId: 1024, EndId: 2048},
+				GemportRange: common.IdRange{StartId: 1024, EndId: 2048},
+			},
+		},
+	}
+	_, err = CreateOLT(*common.Config, common.Services, true)
+	assert.Error(t, err)
+
+	// an unknown PON technology
+	common.PonsConfig.Ranges[0].OnuRange = common.IdRange{StartId: 1, EndId: 4}
+	common.PonsConfig.Ranges[0].Technology = "BPON"
+	_, err = CreateOLT(*common.Config, common.Services, true)
+	assert.Error(t, err)
+
+	// a total ONU count above the configured limit
+	common.PonsConfig.Ranges[0].Technology = common.XGSPON.String()
+	common.Config.Olt.MaxOnus = 4
+	_, err = CreateOLT(*common.Config, common.Services, true)
+	assert.Error(t, err)
+}
+
+// BenchmarkCreateOLT measures startup time for a large topology, the size
+// that motivated spreading ONU creation over a pool of workers
+func BenchmarkCreateOLT(b *testing.B) {
+
+	common.Services = []common.ServiceYaml{
+		{Name: "hsia", CTag: 900, CTagAllocation: common.TagAllocationShared.String(), STag: 900, STagAllocation: common.TagAllocationShared.String()},
+	}
+
+	common.Config = &common.GlobalConfig{
+		Olt: common.OltConfig{
+			ID:              1,
+			PonPorts:        64,
+			OnusPonPort:     128,
+			UniPorts:        1,
+			PortStatsSource: common.PortStatsSourceGenerated,
+		},
+	}
+
+	common.PonsConfig = &common.PonPortsConfig{
+		Number: common.Config.Olt.PonPorts,
+		Ranges: []common.PonRangeConfig{
+			{
+				PonRange:     common.IdRange{StartId: 0, EndId: common.Config.Olt.PonPorts - 1},
+				Technology:   common.XGSPON.String(),
+				OnuRange:     common.IdRange{StartId: 1, EndId: common.Config.Olt.OnusPonPort},
+				AllocIdRange: common.IdRange{StartId: 1024, EndId: 1024 + common.Config.Olt.OnusPonPort*4},
+				GemportRange: common.IdRange{StartId: 1024, EndId: 1024 + common.Config.Olt.OnusPonPort*32},
+			},
+		},
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, err := CreateOLT(*common.Config, common.Services, true); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func TestGetDeviceInfo(t *testing.T) {
 
 	var onusPerPon uint32 = 4
@@ -268,7 +365,8 @@ func TestGetDeviceInfo(t *testing.T) {
 		},
 	}
 
-	olt := CreateOLT(*common.Config, common.Services, true)
+	olt, err := CreateOLT(*common.Config, common.Services, true)
+	assert.NoError(t, err)
 
 	res, err := olt.GetDeviceInfo(context.Background(), &openolt.Empty{})
 
@@ -842,3 +940,740 @@ func Test_Olt_OmciMsgOut(t *testing.T) {
 	assert.Equal(t, len(onu.Channel), 1) // check that one message have been sent
 
 }
+
+func TestGetStateSnapshot(t *testing.T) {
+	olt := OltDevice{
+		ID:            1,
+		SerialNumber:  "ETRI_OLT_1",
+		InternalState: fsm.NewFSM(OltInternalStateEnabled, fsm.Events{}, fsm.Callbacks{}),
+		OperState:     getOperStateFSM(func(e *fsm.Event) {}),
+	}
+
+	olt.Pons = append(olt.Pons, &PonPort{
+		ID:            0,
+		InternalState: fsm.NewFSM("enabled", fsm.Events{}, fsm.Callbacks{}),
+		OperState:     getOperStateFSM(func(e *fsm.Event) {}),
+	})
+
+	olt.Nnis = append(olt.Nnis, &NniPort{
+		ID:        0,
+		OperState: getOperStateFSM(func(e *fsm.Event) {}),
+	})
+
+	snapshot := olt.GetStateSnapshot()
+	assert.Equal(t, 1, snapshot.ID)
+	assert.Equal(t, "ETRI_OLT_1", snapshot.SerialNumber)
+	assert.Equal(t, OltInternalStateEnabled, snapshot.InternalState)
+	assert.Equal(t, "down", snapshot.OperState)
+	assert.Equal(t, 1, len(snapshot.Pons))
+	assert.Equal(t, "enabled", snapshot.Pons[0].InternalState)
+	assert.Equal(t, "down", snapshot.Pons[0].OperState)
+	assert.Equal(t, 1, len(snapshot.Nnis))
+	assert.Equal(t, "down", snapshot.Nnis[0].OperState)
+}
+
+// run with -race: the BOSS setters and getters may be invoked concurrently
+// by multiple provisioning clients
+func TestBossMacConfigConcurrentAccess(t *testing.T) {
+	olt := &OltDevice{
+		InternalState: fsm.NewFSM(OltInternalStateEnabled, fsm.Events{}, fsm.Callbacks{}),
+	}
+	ctx := context.TODO()
+
+	setMtu := &bossopenolt.BossRequest{DeviceId: "BBSIMOLT000", Param: &bossopenolt.ParamFields{
+		Data: &bossopenolt.ParamFields_SetmtusizeParam{SetmtusizeParam: &bossopenolt.SetMtuSize{MtuSize: 1522}},
+	}}
+	setVlan := &bossopenolt.BossRequest{DeviceId: "BBSIMOLT000", Param: &bossopenolt.ParamFields{
+		Data: &bossopenolt.ParamFields_SetvlanParam{SetvlanParam: &bossopenolt.SetVlan{Action: 1}},
+	}}
+	setValue := &bossopenolt.BossRequest{DeviceId: "BBSIMOLT000", Param: &bossopenolt.ParamFields{
+		Data: &bossopenolt.ParamFields_IntegervalueParam{IntegervalueParam: &bossopenolt.IntegerValue{Value: 10}},
+	}}
+	get := &bossopenolt.BossRequest{DeviceId: "BBSIMOLT000"}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_, _ = olt.SetMtuSize(ctx, setMtu)
+				_, _ = olt.GetMtuSize(ctx, get)
+				_, _ = olt.SetVlan(ctx, setVlan)
+				_, _ = olt.GetVlan(ctx, get)
+				_, _ = olt.SetAgingMode(ctx, setValue)
+				_, _ = olt.GetAgingMode(ctx, get)
+				_, _ = olt.SetAgingTime(ctx, setValue)
+				_, _ = olt.GetAgingTime(ctx, get)
+				_, _ = olt.GetDeviceMacInfo(ctx, get)
+			}
+		}()
+	}
+	wg.Wait()
+
+	macInfo, err := olt.GetDeviceMacInfo(ctx, get)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1522), macInfo.Mtu)
+	assert.Equal(t, int32(1), macInfo.VlanMode)
+	assert.Equal(t, int32(10), macInfo.AgingMode)
+	assert.Equal(t, int32(10), macInfo.AgingTime)
+}
+
+func Test_Olt_PonDbaAdmissionControl(t *testing.T) {
+	olt := createMockOlt(1, 2, 1, []ServiceIf{})
+
+	common.PonsConfig = &common.PonPortsConfig{
+		Number: 1,
+		Ranges: []common.PonRangeConfig{
+			{
+				PonRange:        common.IdRange{StartId: 0, EndId: 0},
+				DbaCapacityMbps: 100,
+			},
+		},
+	}
+	defer func() { common.PonsConfig = nil }()
+
+	addSla := func(onuId int32, tcont int32, abmin int32) int32 {
+		res, err := olt.AddOnuSla(context.TODO(), &bossopenolt.BossRequest{
+			DeviceId: "BBSIM_OLT_0",
+			Param: &bossopenolt.ParamFields{
+				Data: &bossopenolt.ParamFields_AddonuslaParam{
+					AddonuslaParam: &bossopenolt.AddOnuSla{OnuId: onuId, Tcont: tcont, Abmin: abmin},
+				},
+			},
+		})
+		assert.NoError(t, err)
+		return res.Result
+	}
+
+	// 60 + 30 Mbps fit in the 100 Mbps capacity
+	assert.Equal(t, int32(0), addSla(0, 1, 60))
+	assert.Equal(t, int32(0), addSla(1, 1, 30))
+
+	// another 30 Mbps would exceed it
+	assert.Equal(t, int32(1), addSla(1, 2, 30))
+
+	// but replacing the SLA on an existing T-CONT only commits the difference
+	assert.Equal(t, int32(0), addSla(1, 1, 40))
+}
+
+func Test_Olt_SendPonIndicationNilStream(t *testing.T) {
+	olt := createMockOlt(1, 2, 1, []ServiceIf{})
+
+	// a PON indication firing before Enable stored the stream must be
+	// dropped, not panic
+	olt.sendPonIndication(0)
+	olt.sendOltIndication(bbsim.OltIndicationMessage{OperState: bbsim.UP}, nil)
+}
+
+func Test_Olt_ParseStartupIndicationOrder(t *testing.T) {
+	order, err := parseStartupIndicationOrder("nni, pon,olt")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"nni", "pon", "olt"}, order)
+
+	_, err = parseStartupIndicationOrder("olt,nni")
+	assert.Error(t, err)
+	_, err = parseStartupIndicationOrder("olt,nni,nni")
+	assert.Error(t, err)
+	_, err = parseStartupIndicationOrder("olt,nni,foo")
+	assert.Error(t, err)
+}
+
+func Test_Olt_FlowConflictStructured(t *testing.T) {
+	olt := createMockOlt(1, 2, 1, []ServiceIf{})
+	olt.GemPortIDs[0][0][16] = map[int32]map[uint64]bool{1024: {64: true}}
+
+	flow := &openolt.Flow{
+		AccessIntfId: 0,
+		OnuId:        1,
+		GemportId:    1024,
+		FlowId:       65,
+	}
+	err := olt.validateFlow(flow)
+	assert.Error(t, err)
+
+	// the conflict details can be asserted on without parsing the message
+	var conflict FlowConflict
+	assert.True(t, errors.As(err, &conflict))
+	assert.Equal(t, FlowConflictGemPort, conflict.Kind)
+	assert.Equal(t, int32(1024), conflict.Id)
+	assert.Equal(t, uint32(0), conflict.OnuId)
+	assert.Equal(t, uint32(16), conflict.UniId)
+	assert.Equal(t, uint64(65), conflict.FlowId)
+	assert.Equal(t, uint64(64), conflict.OtherFlowId)
+	assert.Equal(t, "gem-1024-already-in-use-on-uni-16-onu-0-flow-65", err.Error())
+}
+
+func Test_Olt_MulticastReplication(t *testing.T) {
+	olt := createMockOlt(1, 2, 1, []ServiceIf{})
+	olt.mcastFlows = make(map[uint64]*openolt.Flow)
+	olt.Pons[0].Olt = olt
+	olt.Nnis = append(olt.Nnis, &NniPort{ID: 0, Olt: olt})
+	// skip the flow bookkeeping meant for the openolt adapter, it relies on
+	// the package level olt instance which a mock OLT is not
+	olt.enablePerf = true
+
+	groupIp := net.ParseIP("239.0.0.1")
+	flow := openolt.Flow{
+		AccessIntfId: 0,
+		FlowId:       64,
+		FlowType:     "multicast",
+		GroupId:      7,
+		Classifier: &openolt.Classifier{
+			DstIp: binary.BigEndian.Uint32(groupIp.To4()),
+		},
+	}
+	_, err := olt.FlowAdd(context.Background(), &flow)
+	assert.Nil(t, err)
+
+	member := olt.Pons[0].Onus[0]
+	nonMember := olt.Pons[0].Onus[1]
+	member.joinMcastGroup(flow.GroupId)
+
+	mcastPacket := func(dstIp net.IP) []byte {
+		eth := &layers.Ethernet{
+			SrcMAC:       net.HardwareAddr{0x2e, 0x60, 0x70, 0x13, 0x15, 0x16},
+			DstMAC:       net.HardwareAddr{0x01, 0x00, 0x5e, 0x00, 0x00, 0x01},
+			EthernetType: layers.EthernetTypeIPv4,
+		}
+		ip := &layers.IPv4{
+			Version:  4,
+			TTL:      64,
+			Protocol: layers.IPProtocolUDP,
+			SrcIP:    net.ParseIP("10.0.0.1"),
+			DstIP:    dstIp,
+		}
+		udp := &layers.UDP{SrcPort: 5000, DstPort: 5000}
+		_ = udp.SetNetworkLayerForChecksum(ip)
+		buffer := gopacket.NewSerializeBuffer()
+		opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+		_ = gopacket.SerializeLayers(buffer, opts, eth, ip, udp, gopacket.Payload([]byte{0x01, 0x02}))
+		return buffer.Bytes()
+	}
+
+	// a packet to the group's address only reaches the member
+	_, err = olt.UplinkPacketOut(context.Background(), &openolt.UplinkPacket{IntfId: 0, Pkt: mcastPacket(groupIp)})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), atomic.LoadUint64(&member.McastRxPackets))
+	assert.Equal(t, uint64(0), atomic.LoadUint64(&nonMember.McastRxPackets))
+
+	// multicast traffic without a matching flow is not replicated
+	_, err = olt.UplinkPacketOut(context.Background(), &openolt.UplinkPacket{IntfId: 0, Pkt: mcastPacket(net.ParseIP("239.0.0.2"))})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), atomic.LoadUint64(&member.McastRxPackets))
+
+	// removing the flow stops the replication
+	_, err = olt.FlowRemove(context.Background(), &flow)
+	assert.Nil(t, err)
+	_, err = olt.UplinkPacketOut(context.Background(), &openolt.UplinkPacket{IntfId: 0, Pkt: mcastPacket(groupIp)})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), atomic.LoadUint64(&member.McastRxPackets))
+}
+
+func Test_Olt_NewSignature(t *testing.T) {
+	olt := OltDevice{signatureMode: "fixed", signatureFixed: 42}
+	assert.Equal(t, uint32(42), olt.newSignature())
+	assert.Equal(t, uint32(42), olt.newSignature())
+
+	olt = OltDevice{signatureMode: "counter"}
+	assert.Equal(t, uint32(1), olt.newSignature())
+	assert.Equal(t, uint32(2), olt.newSignature())
+}
+
+func Test_Olt_GemPortEncryption(t *testing.T) {
+	olt := createMockOlt(1, 1, 1, []ServiceIf{})
+	olt.gemPortEncryption = make(map[uint32]map[uint32]bool)
+
+	tq := &tech_profile.TrafficQueues{
+		IntfId: 0,
+		OnuId:  1,
+		TrafficQueues: []*tech_profile.TrafficQueue{
+			{GemportId: 1024, AesEncryption: true},
+			{GemportId: 1025, AesEncryption: false},
+		},
+	}
+	_, err := olt.CreateTrafficQueues(context.Background(), tq)
+	assert.Nil(t, err)
+
+	assert.Equal(t, true, olt.GemPortEncrypted(0, 1024))
+	assert.Equal(t, false, olt.GemPortEncrypted(0, 1025))
+	// never provisioned
+	assert.Equal(t, false, olt.GemPortEncrypted(0, 1026))
+
+	_, err = olt.RemoveTrafficQueues(context.Background(), tq)
+	assert.Nil(t, err)
+	assert.Equal(t, false, olt.GemPortEncrypted(0, 1024))
+}
+
+func Test_Olt_ClearFlows(t *testing.T) {
+	olt := createMockOlt(1, 2, 1, []ServiceIf{})
+	olt.enablePerf = false
+
+	onu0 := olt.Pons[0].Onus[0]
+	onu1 := olt.Pons[0].Onus[1]
+
+	storeFlow := func(flowId uint64, onu *Onu) {
+		flow := openolt.Flow{
+			FlowId:       flowId,
+			AccessIntfId: int32(onu.PonPortID),
+			OnuId:        int32(onu.ID),
+			FlowType:     "downstream",
+		}
+		olt.Flows.Store(FlowKey{ID: flowId}, flow)
+		onu.FlowIds = append(onu.FlowIds, flowId)
+	}
+
+	storeFlow(1, onu0)
+	storeFlow(2, onu0)
+	storeFlow(3, onu1)
+
+	// clearing a single ONU leaves the other one untouched
+	removed, err := olt.ClearFlows(int32(olt.Pons[0].ID), int32(onu0.ID))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, removed)
+	assert.Equal(t, 0, len(onu0.FlowIds))
+	assert.Equal(t, 1, len(onu1.FlowIds))
+	_, ok := olt.Flows.Load(FlowKey{ID: 3})
+	assert.Equal(t, true, ok)
+
+	// clearing the whole PON removes what is left
+	removed, err = olt.ClearFlows(int32(olt.Pons[0].ID), -1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, removed)
+	assert.Equal(t, 0, len(onu1.FlowIds))
+
+	// flows are not stored when enablePerf is set
+	olt.enablePerf = true
+	_, err = olt.ClearFlows(int32(olt.Pons[0].ID), -1)
+	assert.NotNil(t, err)
+}
+
+func Test_Olt_DropMalformedPackets(t *testing.T) {
+	olt := createMockOlt(1, 1, 1, []ServiceIf{})
+	onu := olt.Pons[0].Onus[0]
+
+	garbage := []byte{0xde, 0xad, 0xbe, 0xef}
+
+	_, err := olt.UplinkPacketOut(context.Background(), &openolt.UplinkPacket{IntfId: 0, Pkt: garbage})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), atomic.LoadUint64(&olt.MalformedPackets))
+
+	_, err = olt.OnuPacketOut(context.Background(), &openolt.OnuPacket{IntfId: 0, OnuId: onu.ID, Pkt: garbage})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), atomic.LoadUint64(&olt.MalformedPackets))
+
+	// nothing reached the ONU channel
+	assert.Equal(t, 0, len(onu.Channel))
+}
+
+func Test_Olt_GetSlaTableFiltering(t *testing.T) {
+	olt := createMockOlt(1, 2, 1, []ServiceIf{})
+
+	addSla := func(onuId int32, tcont int32, abmin int32) {
+		_, err := olt.AddOnuSla(context.TODO(), &bossopenolt.BossRequest{
+			DeviceId: "BBSIM_OLT_0",
+			Param: &bossopenolt.ParamFields{
+				Data: &bossopenolt.ParamFields_AddonuslaParam{
+					AddonuslaParam: &bossopenolt.AddOnuSla{OnuId: onuId, Tcont: tcont, Abmin: abmin},
+				},
+			},
+		})
+		assert.NoError(t, err)
+	}
+
+	addSla(1, 1, 10)
+	addSla(1, 2, 20)
+	addSla(2, 1, 30)
+
+	getTable := func(onuId int32, tcont int32) []*bossopenolt.SlaResponse {
+		req := &bossopenolt.BossRequest{DeviceId: "BBSIM_OLT_0"}
+		if onuId != 0 || tcont != 0 {
+			req.Param = &bossopenolt.ParamFields{
+				Data: &bossopenolt.ParamFields_ClearonuslaParam{
+					ClearonuslaParam: &bossopenolt.ClearOnuSla{OnuId: onuId, Tcont: tcont},
+				},
+			}
+		}
+		res, err := olt.GetSlaTable(context.TODO(), req)
+		assert.NoError(t, err)
+		return res.Resp
+	}
+
+	// no filter returns the whole table, ordered by ONU and T-CONT
+	table := getTable(0, 0)
+	assert.Equal(t, 3, len(table))
+	assert.Equal(t, int32(1), table[0].OnuId)
+	assert.Equal(t, int32(1), table[0].Tcont)
+	assert.Equal(t, int32(10), table[0].Abmin)
+	assert.Equal(t, int32(2), table[2].OnuId)
+
+	// filter by ONU
+	table = getTable(1, 0)
+	assert.Equal(t, 2, len(table))
+
+	// filter by ONU and T-CONT
+	table = getTable(1, 2)
+	assert.Equal(t, 1, len(table))
+	assert.Equal(t, int32(20), table[0].Abmin)
+
+	// no match
+	assert.Equal(t, 0, len(getTable(2, 2)))
+}
+
+func Test_Olt_MessageWatchdog(t *testing.T) {
+	mockOlt := createMockOlt(1, 1, 1, []ServiceIf{})
+	mockOlt.msgWatchdogInterval = 5 * time.Millisecond
+	mockOlt.channel = make(chan bbsim.Message, 10)
+
+	// events are delivered through the package level olt instance
+	events := olt.SubscribeEvents()
+	defer olt.UnsubscribeEvents(events)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// a pending message that nobody drains must trip the watchdog
+	mockOlt.channel <- bbsim.Message{Type: bbsim.OltIndication}
+	go mockOlt.watchOltMessages(ctx)
+
+	select {
+	case event := <-events:
+		assert.Equal(t, "OLT-indication-loop-stuck", event.EventType)
+	case <-time.After(1 * time.Second):
+		t.Fatal("watchdog did not report the stuck indication loop")
+	}
+
+	// once the message is drained and the timestamp refreshed it stays quiet
+	<-mockOlt.channel
+	atomic.StoreInt64(&mockOlt.lastOltMsgProcessed, deviceClock.Now().UnixNano())
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected event %s from the watchdog", event.EventType)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func Test_Onu_SendMessageAcrossReboot(t *testing.T) {
+	olt := createMockOlt(1, 1, 1, []ServiceIf{})
+	olt.Pons[0].Olt = olt
+	onu := olt.Pons[0].Onus[0]
+	onu.Channel = make(chan bbsim.Message, 1)
+
+	// before the OLT is enabled there is no reader, the message is refused
+	assert.Error(t, onu.sendMessage(bbsim.Message{Type: bbsim.FlowAdd}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	olt.enableContext = ctx
+	assert.NoError(t, onu.sendMessage(bbsim.Message{Type: bbsim.FlowAdd}))
+
+	// a sender stuck on a full channel is released when the enable context
+	// is canceled, as happens on reboot, instead of leaking
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- onu.sendMessage(bbsim.Message{Type: bbsim.FlowAdd})
+	}()
+	cancel()
+	select {
+	case err := <-errCh:
+		assert.Error(t, err)
+	case <-time.After(1 * time.Second):
+		t.Fatal("sender was not released by the canceled enable context")
+	}
+
+	// messages sent after the cancel are refused outright
+	assert.Error(t, onu.sendMessage(bbsim.Message{Type: bbsim.FlowAdd}))
+
+	// the reboot path drops whatever was still queued
+	onu.flushChannel()
+	assert.Equal(t, 0, len(onu.Channel))
+}
+
+func Test_Olt_BurstParametersRoundTrip(t *testing.T) {
+	olt := createMockOlt(1, 1, 1, []ServiceIf{})
+	ctx := context.TODO()
+
+	_, err := olt.SetBurstDelimiter(ctx, &bossopenolt.BossRequest{
+		DeviceId: "BBSIM_OLT_0",
+		Param: &bossopenolt.ParamFields{
+			Data: &bossopenolt.ParamFields_SetburstdelimitParam{
+				SetburstdelimitParam: &bossopenolt.SetBurstDelimit{Length: 4, Delimiter: "0xab12"},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	delimiter, err := olt.GetBurstDelimiter(ctx, &bossopenolt.BossRequest{DeviceId: "BBSIM_OLT_0"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), delimiter.Length)
+	assert.Equal(t, "0xab12", delimiter.Delimiter)
+
+	_, err = olt.SetBurstPreamble(ctx, &bossopenolt.BossRequest{
+		DeviceId: "BBSIM_OLT_0",
+		Param: &bossopenolt.ParamFields{
+			Data: &bossopenolt.ParamFields_SetburstpreambleParam{
+				SetburstpreambleParam: &bossopenolt.SetBurstPreamble{Repeat: 40},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	preamble, err := olt.GetBurstPreamble(ctx, &bossopenolt.BossRequest{DeviceId: "BBSIM_OLT_0"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(40), preamble.Repeat)
+
+	_, err = olt.SetBurstVersion(ctx, &bossopenolt.BossRequest{
+		DeviceId: "BBSIM_OLT_0",
+		Param: &bossopenolt.ParamFields{
+			Data: &bossopenolt.ParamFields_SetburstversionParam{
+				SetburstversionParam: &bossopenolt.SetBurstVersion{Version: "2", Index: 5, Pontag: 0x42},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	version, err := olt.GetBurstVersion(ctx, &bossopenolt.BossRequest{DeviceId: "BBSIM_OLT_0"})
+	assert.NoError(t, err)
+	assert.Equal(t, "2", version.Version)
+	assert.Equal(t, int32(5), version.Index)
+	assert.Equal(t, int64(0x42), version.Pontag)
+}
+
+func Test_Olt_ActivateOnuOpticalBudget(t *testing.T) {
+	olt := createMockOlt(1, 1, 1, []ServiceIf{})
+	olt.Pons[0].Olt = olt
+	olt.channel = make(chan types.Message, 10)
+
+	common.PonsConfig = &common.PonPortsConfig{
+		Number: 1,
+		Ranges: []common.PonRangeConfig{
+			{
+				PonRange:         common.IdRange{StartId: 0, EndId: 0},
+				MaxDistanceKm:    20,
+				RxSensitivityDbm: -28,
+			},
+		},
+	}
+	defer func() { common.PonsConfig = nil }()
+
+	onu := olt.Pons[0].Onus[0]
+
+	// an ONU past the maximum distance fails activation with a LOS alarm
+	onu.DistanceKm = 25
+	onu.RxPower = -20
+	_, err := olt.ActivateOnu(context.TODO(), &openolt.Onu{
+		IntfId:       0,
+		OnuId:        onu.ID,
+		SerialNumber: onu.SerialNumber,
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 1, len(olt.channel))
+
+	// so does one whose rx-power is below the PON sensitivity
+	onu.DistanceKm = 10
+	onu.RxPower = -30
+	_, err = olt.ActivateOnu(context.TODO(), &openolt.Onu{
+		IntfId:       0,
+		OnuId:        onu.ID,
+		SerialNumber: onu.SerialNumber,
+	})
+	assert.Error(t, err)
+
+	// an ONU within both limits passes the budget check
+	onu.RxPower = -20
+	assert.NoError(t, olt.Pons[0].checkOpticalBudget(onu))
+}
+
+func Test_Onu_SetDistance(t *testing.T) {
+	olt := createMockOlt(1, 1, 1, []ServiceIf{})
+	olt.Pons[0].Olt = olt
+	olt.channel = make(chan types.Message, 10)
+
+	common.PonsConfig = &common.PonPortsConfig{
+		Number: 1,
+		Ranges: []common.PonRangeConfig{
+			{
+				PonRange:      common.IdRange{StartId: 0, EndId: 0},
+				MaxDistanceKm: 20,
+			},
+		},
+	}
+	defer func() { common.PonsConfig = nil }()
+
+	onu := olt.Pons[0].Onus[0]
+
+	// a move within the budget re-ranges without raising an alarm
+	assert.NoError(t, onu.SetDistance(10))
+	assert.Equal(t, uint32(10), onu.DistanceKm)
+	assert.Equal(t, 0, len(olt.channel))
+
+	// a move out of the budget still updates the distance but raises a LOS alarm
+	assert.Error(t, onu.SetDistance(25))
+	assert.Equal(t, uint32(25), onu.DistanceKm)
+	assert.Equal(t, 1, len(olt.channel))
+}
+
+func Test_Olt_OnuVendorId(t *testing.T) {
+	olt := createMockOlt(1, 1, 1, []ServiceIf{})
+	olt.InternalState = fsm.NewFSM(OltInternalStateEnabled, fsm.Events{}, fsm.Callbacks{})
+
+	// mock ONUs are built directly rather than through CreateONU, so the
+	// default vendor id is only reported through the accessor
+	onu := olt.Pons[0].Onus[0]
+	assert.Equal(t, "ETRI", onu.vendorId())
+
+	// CreateONU generates the MIB database, which reads the global config
+	common.Config = &common.GlobalConfig{}
+
+	// a vendor pinned to a slot in the configuration overrides the default
+	olt.OnuVendors = map[string]string{"0/5": "BRCM"}
+	pinned := CreateONU(olt, olt.Pons[0], 5, 0, NewTagAllocator(0, 0), true)
+	assert.Equal(t, "BRCM", pinned.VendorId)
+
+	// AddOnu and GetOnuInfo report the same per-ONU vendor id
+	onu.VendorId = "ACME"
+	req := &bossopenolt.BossRequest{
+		DeviceId: "BBSIM_OLT_0",
+		Param: &bossopenolt.ParamFields{
+			Data: &bossopenolt.ParamFields_OnuctrlParam{
+				OnuctrlParam: &bossopenolt.OnuCtrl{OnuId: int32(onu.ID)},
+			},
+		},
+	}
+
+	addRes, err := olt.AddOnu(context.TODO(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, "ACME", addRes.VendorId)
+
+	infoRes, err := olt.GetOnuInfo(context.TODO(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, "ACME", infoRes.VendorId)
+}
+
+func Test_Olt_BipErrorInjection(t *testing.T) {
+	olt := createMockOlt(1, 1, 1, []ServiceIf{})
+	olt.InternalState = fsm.NewFSM(OltInternalStateEnabled, fsm.Events{}, fsm.Callbacks{})
+	olt.Pons[0].Olt = olt
+
+	// a single injection keyed by the raw interface id drives the port
+	// stats, the FEC codeword counters and the latency measurement
+	olt.SetInjectedBipErrors(0, 42)
+
+	// the port stats override is looked up before the port-no conversion
+	stream := &mockStream{
+		CallCount: 0,
+		Calls:     make(map[int]*openolt.Indication),
+		channel:   make(chan int, 10),
+	}
+	stats := &openolt.PortStatistics{IntfId: 0}
+	olt.sendPortStatsIndication(stats, 0, "pon", stream)
+	assert.Equal(t, uint64(42), stats.BipErrors)
+	assert.Equal(t, InterfaceIDToPortNo(0, "pon"), stats.IntfId)
+
+	// the same injection surfaces as uncorrectable FEC codewords on the ONUs
+	// served by the interface
+	onu := olt.Pons[0].Onus[0]
+	onu.countFecCodewords(100)
+	assert.Equal(t, uint64(1), atomic.LoadUint64(&onu.FecCodewordsUncorrectable))
+
+	// and it skews the latency measurement derived from the armed flow
+	_, err := olt.SetLatencyFlow(context.TODO(), &bossopenolt.BossRequest{
+		DeviceId: "BBSIM_OLT_0",
+		Param: &bossopenolt.ParamFields{
+			Data: &bossopenolt.ParamFields_LatencyflowParam{
+				LatencyflowParam: &bossopenolt.LatencyFlow{Pon: 0, XgemId: 10},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1000+10+42), olt.latencyMeasure)
+
+	_, err = olt.SetLatencyClear(context.TODO(), &bossopenolt.BossRequest{DeviceId: "BBSIM_OLT_0"})
+	assert.NoError(t, err)
+
+	// a count of 0 clears the injection
+	olt.SetInjectedBipErrors(0, 0)
+	_, ok := olt.injectedBipErrorsFor(0)
+	assert.False(t, ok)
+}
+
+func Test_Olt_TakeBossConfigSnapshot(t *testing.T) {
+	olt := createMockOlt(1, 2, 1, []ServiceIf{})
+	olt.InternalState = fsm.NewFSM(OltInternalStateEnabled, fsm.Events{}, fsm.Callbacks{})
+	ctx := context.TODO()
+
+	_, err := olt.SetMtuSize(ctx, &bossopenolt.BossRequest{
+		DeviceId: "BBSIM_OLT_0",
+		Param: &bossopenolt.ParamFields{
+			Data: &bossopenolt.ParamFields_SetmtusizeParam{
+				SetmtusizeParam: &bossopenolt.SetMtuSize{MtuSize: 9000},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	_, err = olt.AddOnuSla(ctx, &bossopenolt.BossRequest{
+		DeviceId: "BBSIM_OLT_0",
+		Param: &bossopenolt.ParamFields{
+			Data: &bossopenolt.ParamFields_AddonuslaParam{
+				AddonuslaParam: &bossopenolt.AddOnuSla{OnuId: 1, Tcont: 2, Abmin: 30},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	snapshot := olt.TakeBossConfigSnapshot()
+	assert.Equal(t, int32(9000), snapshot.Mac.Mtu)
+	assert.Equal(t, 1, len(snapshot.Slas))
+	assert.Equal(t, int32(30), snapshot.Slas[0].Abmin)
+}
+
+func Test_Olt_ResetBossConfig(t *testing.T) {
+	olt := createMockOlt(1, 1, 1, []ServiceIf{})
+	olt.InternalState = fsm.NewFSM(OltInternalStateEnabled, fsm.Events{}, fsm.Callbacks{})
+	ctx := context.TODO()
+
+	_, err := olt.SetMtuSize(ctx, &bossopenolt.BossRequest{
+		DeviceId: "BBSIM_OLT_0",
+		Param: &bossopenolt.ParamFields{
+			Data: &bossopenolt.ParamFields_SetmtusizeParam{
+				SetmtusizeParam: &bossopenolt.SetMtuSize{MtuSize: 9000},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	_, err = olt.AddOnuSla(ctx, &bossopenolt.BossRequest{
+		DeviceId: "BBSIM_OLT_0",
+		Param: &bossopenolt.ParamFields{
+			Data: &bossopenolt.ParamFields_AddonuslaParam{
+				AddonuslaParam: &bossopenolt.AddOnuSla{OnuId: 0, Tcont: 1, Abmin: 30},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	// a hard reboot brings the OLT back factory-fresh
+	olt.resetBossConfig()
+
+	snapshot := olt.TakeBossConfigSnapshot()
+	assert.Equal(t, defaultBossMacConfig(), snapshot.Mac)
+	assert.Equal(t, defaultBossBurstConfig(), snapshot.Burst)
+	assert.Equal(t, int32(defaultErrorPermit), snapshot.ErrorPermit)
+	assert.Equal(t, int32(defaultFecDecMode), snapshot.FecDecMode)
+	assert.Equal(t, 0, len(snapshot.Slas))
+}
+
+func Test_Olt_RestartOLTWithModeValidation(t *testing.T) {
+	olt := createMockOlt(1, 1, 1, []ServiceIf{})
+	olt.InternalState = fsm.NewFSM(OltInternalStateDisabled, fsm.Events{}, fsm.Callbacks{})
+
+	// an unknown mode is rejected
+	err := olt.RestartOLTWithMode("warm")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown-reboot-mode")
+
+	// a soft reboot requires the OLT to be enabled
+	err = olt.RestartOLTWithMode("soft")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "olt-must-be-enabled")
+}
@@ -33,6 +33,7 @@ import (
 
 	"net"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	bbsim "github.com/opencord/bbsim/internal/bbsim/types"
@@ -50,12 +51,24 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-var onuLogger = log.WithFields(log.Fields{
+var onuLogger = deviceLogger.WithFields(log.Fields{
 	"module": "ONU",
 })
 
 const (
 	maxOmciMsgCounter = 10
+
+	// defaultRxPower is the optical receive power in dBm reported for a
+	// healthy ONU, before any drift is applied
+	defaultRxPower = -20.0
+
+	// defaultDistanceKm is the simulated fiber distance of an ONU, matching
+	// the distance historically reported by the BOSS endpoints
+	defaultDistanceKm = 1
+
+	// defaultOnuVendorId is the vendor id reported for an ONU unless one is
+	// pinned to its slot in the configuration
+	defaultOnuVendorId = "ETRI"
 )
 
 const (
@@ -117,6 +130,50 @@ type Onu struct {
 
 	AdminLockState uint8 // 0 is enabled, 1 is disabled.
 
+	// when true the ONU is not automatically re-discovered after a delete or
+	// deactivate, it stays down until explicitly re-activated
+	SuppressRediscovery bool
+
+	// timestamp of the last InternalState transition and the time the ONU
+	// came up, used to report per-ONU uptime. Guarded by stateTimeLock
+	stateTimeLock   sync.RWMutex
+	lastStateChange time.Time
+	enabledAt       time.Time
+
+	// simulated optical receive power in dBm, reported by GetPonRxPower
+	RxPower            float64
+	rxPowerDriftCancel context.CancelFunc
+
+	// simulated fiber distance in km, evaluated against the PON's optical
+	// budget when the ONU is activated
+	DistanceKm uint32
+
+	// vendor id reported by the BOSS AddOnu and GetOnuInfo endpoints
+	VendorId string
+
+	// FEC codeword counters, incremented while traffic is forwarded and
+	// reported by GetOnuStatistics. Accessed atomically.
+	FecCodewords              uint64
+	FecCodewordsCorrected     uint64
+	FecCodewordsUncorrectable uint64
+
+	// downstream packets dropped because the ONU was down when VOLTHA sent
+	// them via OnuPacketOut. Accessed atomically.
+	DroppedPackets uint64
+
+	// downstream multicast packets replicated to this ONU because it is a
+	// member of the packet's group. Accessed atomically.
+	McastRxPackets uint64
+
+	// OMCI messages dropped because the ONU channel was full, so one slow
+	// ONU cannot stall the gRPC handlers. Accessed atomically.
+	OmciChannelDrops uint64
+
+	// decoded types of the OMCI requests currently queued on the ONU
+	// channel, kept for inspection via GetOnuOmciQueue
+	pendingOmciLock  sync.Mutex
+	pendingOmciTypes []string
+
 	Channel chan bbsim.Message // this Channel is to track state changes OMCI messages, EAPOL and DHCP packets
 
 	// OMCI params
@@ -131,6 +188,9 @@ type Onu struct {
 	CommittedImageVersion         string
 	OmciResponseRate              uint8
 	OmciMsgCounter                uint8
+	// latency in milliseconds applied before every OMCI response, used to
+	// simulate ONUs with slow management processors, reset on ONU disable
+	OmciResponseLatencyMs uint32
 	ImageSectionData              []byte
 
 	// OMCI params (Used in BBR)
@@ -143,13 +203,347 @@ type Onu struct {
 	TrafficSchedulers *tech_profile.TrafficSchedulers
 	onuAlarmsInfoLock sync.RWMutex
 	onuAlarmsInfo     map[omcilib.OnuAlarmInfoMapKey]omcilib.OnuAlarmInfo
+
+	// faults injected via the BBSim API on specific OMCI message types,
+	// used to simulate ONUs misbehaving during the OMCI exchange
+	omciFaultsLock sync.RWMutex
+	omciFaults     map[omci.MessageType]OmciFault
+
+	// optional OMCI managed entities this ONU does not support: Get and Set
+	// requests targeting them are answered with an UnknownEntity result.
+	// Seeded from the global configuration and adjustable per ONU.
+	unsupportedMesLock sync.RWMutex
+	unsupportedMes     map[me.ClassID]bool
+
+	// snapshots of table attributes latched by a Get request and paged out
+	// through GetNext responses, keyed by ME class, instance and mask
+	omciTableLock      sync.Mutex
+	omciTableSnapshots map[omciTableKey][]byte
+
+	// multicast subscription state built from the group operations received
+	// by the OLT, surfaced through the MulticastSubscriberMonitor ME
+	mcastLock                sync.RWMutex
+	mcastActiveGroups        []uint32
+	JoinMessagesCounter      uint32
+	BandwidthExceededCounter uint32
+
+	// injected AAL5 PM history counters and the time they were armed, used
+	// to advance the interval end time across 15 minute windows
+	aal5PmLock     sync.RWMutex
+	aal5PmCounters omcilib.Aal5PmCounters
+	aal5PmBaseline time.Time
+
+	// OctetString ME instances created by the OLT, holding up to 375 bytes
+	// of opaque payload each
+	octetStringLock sync.Mutex
+	octetStrings    map[uint16]*octetStringInstance
+
+	// non-nil while a reboot is pending, closing it aborts the power on
+	rebootLock   sync.Mutex
+	rebootCancel chan struct{}
+}
+
+// octetStringInstance holds the payload of one OctetString ME instance
+type octetStringInstance struct {
+	length uint16
+	data   [omcilib.OctetStringMaxLength]byte
+}
+
+// omciTableKey identifies a latched table attribute snapshot
+type omciTableKey struct {
+	entityClass    me.ClassID
+	entityInstance uint16
+	attributeMask  uint16
+}
+
+// OmciFault describes how an ONU mishandles a given OMCI message type
+type OmciFault struct {
+	// when true the message is silently discarded and no response is sent
+	Drop bool
+	// delay applied before processing the message
+	DelayMs uint32
+}
+
+// omciFaultMsgTypes maps the names accepted by the fault injection API to
+// the OMCI request types the ONU can mishandle
+var omciFaultMsgTypes = map[string]omci.MessageType{
+	"Create":           omci.CreateRequestType,
+	"Delete":           omci.DeleteRequestType,
+	"Set":              omci.SetRequestType,
+	"Get":              omci.GetRequestType,
+	"GetAllAlarms":     omci.GetAllAlarmsRequestType,
+	"GetAllAlarmsNext": omci.GetAllAlarmsNextRequestType,
+	"MibUpload":        omci.MibUploadRequestType,
+	"MibUploadNext":    omci.MibUploadNextRequestType,
+	"MibReset":         omci.MibResetRequestType,
+	"GetNext":          omci.GetNextRequestType,
+	"SynchronizeTime":  omci.SynchronizeTimeRequestType,
+	"Reboot":           omci.RebootRequestType,
+}
+
+// SetOmciFault configures the ONU to drop and/or delay OMCI messages of the
+// given type, replacing any fault previously set for that type
+func (o *Onu) SetOmciFault(msgType string, drop bool, delayMs uint32) error {
+	faultType, ok := omciFaultMsgTypes[msgType]
+	if !ok {
+		return fmt.Errorf("unknown-omci-msg-type-%s", msgType)
+	}
+
+	o.omciFaultsLock.Lock()
+	defer o.omciFaultsLock.Unlock()
+	if o.omciFaults == nil {
+		o.omciFaults = make(map[omci.MessageType]OmciFault)
+	}
+	o.omciFaults[faultType] = OmciFault{Drop: drop, DelayMs: delayMs}
+
+	onuLogger.WithFields(log.Fields{
+		"IntfId":       o.PonPortID,
+		"OnuId":        o.ID,
+		"SerialNumber": o.Sn(),
+		"OmciMsgType":  msgType,
+		"Drop":         drop,
+		"DelayMs":      delayMs,
+	}).Info("injected-omci-fault")
+	return nil
+}
+
+// ClearOmciFaults removes every OMCI fault injected on the ONU
+func (o *Onu) ClearOmciFaults() {
+	o.omciFaultsLock.Lock()
+	defer o.omciFaultsLock.Unlock()
+	o.omciFaults = nil
+
+	onuLogger.WithFields(log.Fields{
+		"IntfId":       o.PonPortID,
+		"OnuId":        o.ID,
+		"SerialNumber": o.Sn(),
+	}).Info("cleared-omci-faults")
+}
+
+// SetMeSupported marks an OMCI managed entity class as supported or not on
+// this ONU, to model vendors with differing optional feature sets
+func (o *Onu) SetMeSupported(classID me.ClassID, supported bool) {
+	o.unsupportedMesLock.Lock()
+	defer o.unsupportedMesLock.Unlock()
+	if supported {
+		delete(o.unsupportedMes, classID)
+	} else {
+		if o.unsupportedMes == nil {
+			o.unsupportedMes = make(map[me.ClassID]bool)
+		}
+		o.unsupportedMes[classID] = true
+	}
+
+	onuLogger.WithFields(log.Fields{
+		"IntfId":       o.PonPortID,
+		"OnuId":        o.ID,
+		"SerialNumber": o.Sn(),
+		"MeClassID":    classID,
+		"Supported":    supported,
+	}).Info("changed-omci-me-support")
+}
+
+func (o *Onu) isMeSupported(classID me.ClassID) bool {
+	o.unsupportedMesLock.RLock()
+	defer o.unsupportedMesLock.RUnlock()
+	return !o.unsupportedMes[classID]
+}
+
+// latchOmciTable stores the snapshot a Get request took of a table attribute,
+// restarting any GetNext read in progress for the same attribute
+func (o *Onu) latchOmciTable(key omciTableKey, table []byte) {
+	o.omciTableLock.Lock()
+	defer o.omciTableLock.Unlock()
+	if o.omciTableSnapshots == nil {
+		o.omciTableSnapshots = make(map[omciTableKey][]byte)
+	}
+	o.omciTableSnapshots[key] = table
+}
+
+func (o *Onu) omciTableSnapshot(key omciTableKey) []byte {
+	o.omciTableLock.Lock()
+	defer o.omciTableLock.Unlock()
+	return o.omciTableSnapshots[key]
+}
+
+// concurrent multicast groups an ONU can forward before further joins start
+// bumping the bandwidth exceeded counter
+const maxMcastGroupsPerOnu = 4
+
+// estimated bandwidth per multicast group, bytes per second
+const mcastGroupBandwidth = 5000000
+
+// joinMcastGroup records a multicast join on this ONU. Joins beyond the
+// concurrent group limit only increment the bandwidth exceeded counter
+func (o *Onu) joinMcastGroup(groupId uint32) {
+	o.mcastLock.Lock()
+	defer o.mcastLock.Unlock()
+	o.JoinMessagesCounter++
+	for _, id := range o.mcastActiveGroups {
+		if id == groupId {
+			return
+		}
+	}
+	if len(o.mcastActiveGroups) >= maxMcastGroupsPerOnu {
+		o.BandwidthExceededCounter++
+		return
+	}
+	o.mcastActiveGroups = append(o.mcastActiveGroups, groupId)
+}
+
+func (o *Onu) leaveMcastGroup(groupId uint32) {
+	o.mcastLock.Lock()
+	defer o.mcastLock.Unlock()
+	for i, id := range o.mcastActiveGroups {
+		if id == groupId {
+			o.mcastActiveGroups = append(o.mcastActiveGroups[:i], o.mcastActiveGroups[i+1:]...)
+			return
+		}
+	}
+}
+
+// inMcastGroup reports whether this ONU is an active member of the group,
+// used to decide if replicated multicast traffic should reach it
+func (o *Onu) inMcastGroup(groupId uint32) bool {
+	o.mcastLock.RLock()
+	defer o.mcastLock.RUnlock()
+	for _, id := range o.mcastActiveGroups {
+		if id == groupId {
+			return true
+		}
+	}
+	return false
+}
+
+// mcastState returns the counters and active group list served by the
+// MulticastSubscriberMonitor ME
+func (o *Onu) mcastState() (joins uint32, bwExceeded uint32, groups []uint32) {
+	o.mcastLock.RLock()
+	defer o.mcastLock.RUnlock()
+	groups = make([]uint32, len(o.mcastActiveGroups))
+	copy(groups, o.mcastActiveGroups)
+	return o.JoinMessagesCounter, o.BandwidthExceededCounter, groups
+}
+
+// createOctetString instantiates an OctetString ME, returning false if the
+// instance already exists
+func (o *Onu) createOctetString(entityInstance uint16) bool {
+	o.octetStringLock.Lock()
+	defer o.octetStringLock.Unlock()
+	if o.octetStrings == nil {
+		o.octetStrings = make(map[uint16]*octetStringInstance)
+	}
+	if _, ok := o.octetStrings[entityInstance]; ok {
+		return false
+	}
+	o.octetStrings[entityInstance] = &octetStringInstance{}
+	return true
+}
+
+// setOctetString applies the Length and Part attributes of a Set request to
+// a stored OctetString instance
+func (o *Onu) setOctetString(entityInstance uint16, attrs me.AttributeValueMap) bool {
+	o.octetStringLock.Lock()
+	defer o.octetStringLock.Unlock()
+	inst, ok := o.octetStrings[entityInstance]
+	if !ok {
+		return false
+	}
+	return omcilib.ApplyOctetStringAttributes(attrs, &inst.length, inst.data[:])
+}
+
+// getOctetString returns a copy of the payload of a stored OctetString instance
+func (o *Onu) getOctetString(entityInstance uint16) (uint16, []byte, bool) {
+	o.octetStringLock.Lock()
+	defer o.octetStringLock.Unlock()
+	inst, ok := o.octetStrings[entityInstance]
+	if !ok {
+		return 0, nil, false
+	}
+	data := make([]byte, len(inst.data))
+	copy(data, inst.data[:])
+	return inst.length, data, true
+}
+
+func (o *Onu) deleteOctetString(entityInstance uint16) {
+	o.octetStringLock.Lock()
+	defer o.octetStringLock.Unlock()
+	delete(o.octetStrings, entityInstance)
+}
+
+// SetMeAlarm queues an OMCI alarm notification raising or clearing an alarm
+// bit of an ME instance that declares an alarm map. Clearing an alarm that is
+// not raised is a no-op
+func (o *Onu) SetMeAlarm(classID me.ClassID, entityId uint16, alarmBit uint8, raise bool) error {
+	if err := omcilib.ValidateMeAlarm(classID, entityId, alarmBit); err != nil {
+		return err
+	}
+
+	if !raise {
+		key := omcilib.OnuAlarmInfoMapKey{MeInstance: entityId, MeClassID: classID}
+		o.onuAlarmsInfoLock.RLock()
+		alarmInfo, ok := o.onuAlarmsInfo[key]
+		o.onuAlarmsInfoLock.RUnlock()
+		octet := alarmBit / 8
+		bit := 7 - (alarmBit % 8)
+		if !ok || (alarmInfo.AlarmBitMap[octet]>>bit)&1 == 0 {
+			onuLogger.WithFields(log.Fields{
+				"IntfId":       o.PonPortID,
+				"OnuId":        o.ID,
+				"SerialNumber": o.Sn(),
+				"MeClassID":    classID,
+				"EntityID":     entityId,
+				"AlarmBit":     alarmBit,
+			}).Debug("ignoring-clear-of-alarm-that-is-not-raised")
+			return nil
+		}
+	}
+
+	o.Channel <- bbsim.Message{
+		Type: bbsim.MeAlarm,
+		Data: bbsim.MeAlarmMessage{
+			EntityClass: uint16(classID),
+			EntityID:    entityId,
+			AlarmBit:    alarmBit,
+			Raise:       raise,
+		},
+	}
+	return nil
+}
+
+// SetAal5PmCounters injects the AAL5 PM history counters and restarts the
+// 15 minute interval clock
+func (o *Onu) SetAal5PmCounters(counters omcilib.Aal5PmCounters) {
+	o.aal5PmLock.Lock()
+	defer o.aal5PmLock.Unlock()
+	o.aal5PmCounters = counters
+	o.aal5PmBaseline = deviceClock.Now()
+}
+
+// aal5PmState returns the injected counters together with the interval end
+// time, a counter of the 15 minute windows closed since injection
+func (o *Onu) aal5PmState() (omcilib.Aal5PmCounters, uint8) {
+	o.aal5PmLock.RLock()
+	defer o.aal5PmLock.RUnlock()
+	if o.aal5PmBaseline.IsZero() {
+		return o.aal5PmCounters, 0
+	}
+	intervals := deviceClock.Now().Sub(o.aal5PmBaseline) / (15 * time.Minute)
+	return o.aal5PmCounters, uint8(intervals)
+}
+
+func (o *Onu) getOmciFault(msgType omci.MessageType) (OmciFault, bool) {
+	o.omciFaultsLock.RLock()
+	defer o.omciFaultsLock.RUnlock()
+	fault, ok := o.omciFaults[msgType]
+	return fault, ok
 }
 
 func (o *Onu) Sn() string {
 	return common.OnuSnToString(o.SerialNumber)
 }
 
-func CreateONU(olt *OltDevice, pon *PonPort, id uint32, delay time.Duration, nextCtag map[string]int, nextStag map[string]int, isMock bool) *Onu {
+func CreateONU(olt *OltDevice, pon *PonPort, id uint32, delay time.Duration, tags *TagAllocator, isMock bool) *Onu {
 
 	o := Onu{
 		ID:                            id,
@@ -173,8 +567,36 @@ func CreateONU(olt *OltDevice, pon *PonPort, id uint32, delay time.Duration, nex
 		CommittedImageVersion:  "BBSM_IMG_00001",
 		OmciResponseRate:       olt.OmciResponseRate,
 		OmciMsgCounter:         0,
+		RxPower:                defaultRxPower,
+		DistanceKm:             defaultDistanceKm,
+		VendorId:               defaultOnuVendorId,
+	}
+	// managed entities globally configured as unsupported apply to every ONU
+	if common.Config != nil && len(common.Config.Olt.UnsupportedOmciMes) > 0 {
+		o.unsupportedMes = make(map[me.ClassID]bool)
+		for _, classID := range common.Config.Olt.UnsupportedOmciMes {
+			o.unsupportedMes[me.ClassID(classID)] = true
+		}
+	}
+	// if a serial number is pinned to this slot in the configuration use it,
+	// otherwise generate one
+	if snString, ok := olt.OnuSerials[fmt.Sprintf("%d/%d", pon.ID, id)]; ok {
+		sn, err := common.OnuSnFromString(snString)
+		if err != nil {
+			onuLogger.WithFields(log.Fields{
+				"IntfId":       pon.ID,
+				"OnuId":        id,
+				"SerialNumber": snString,
+			}).Fatal("cannot-parse-configured-serial-number")
+		}
+		o.SerialNumber = sn
+	} else {
+		o.SerialNumber = NewSN(olt.ID, pon.ID, id)
+	}
+	// same for the vendor id, so vendor-specific adapter logic can be matched
+	if vendor, ok := olt.OnuVendors[fmt.Sprintf("%d/%d", pon.ID, id)]; ok {
+		o.VendorId = vendor
 	}
-	o.SerialNumber = NewSN(olt.ID, pon.ID, id)
 	// NOTE this state machine is used to track the operational
 	// state as requested by VOLTHA
 	o.OperState = getOperStateFSM(func(e *fsm.Event) {
@@ -213,10 +635,11 @@ func CreateONU(olt *OltDevice, pon *PonPort, id uint32, delay time.Duration, nex
 		fsm.Callbacks{
 			"enter_state": func(e *fsm.Event) {
 				o.logStateChange(e.Src, e.Dst)
+				o.recordStateChange(e.Dst)
 			},
 			fmt.Sprintf("enter_%s", OnuStateInitialized): func(e *fsm.Event) {
 				// create new channel for ProcessOnuMessages Go routine
-				o.Channel = make(chan bbsim.Message, 2048)
+				o.Channel = make(chan bbsim.Message, onuChannelDepth())
 
 				if err := o.OperState.Event(OnuTxEnable); err != nil {
 					onuLogger.WithFields(log.Fields{
@@ -345,7 +768,7 @@ func CreateONU(olt *OltDevice, pon *PonPort, id uint32, delay time.Duration, nex
 
 	// create Ethernet UNIs
 	for i := 0; i < olt.NumUni; i++ {
-		uni, err := NewUniPort(uint32(i), &o, nextCtag, nextStag)
+		uni, err := NewUniPort(uint32(i), &o, tags)
 		if err != nil {
 			onuLogger.WithFields(log.Fields{
 				"OnuId":  o.ID,
@@ -391,6 +814,45 @@ func (o *Onu) logStateChange(src string, dst string) {
 	}).Debugf("Changing ONU InternalState from %s to %s", src, dst)
 }
 
+// recordStateChange timestamps every InternalState transition and tracks when
+// the ONU came up so that uptime can be reported over the API
+func (o *Onu) recordStateChange(dst string) {
+	now := deviceClock.Now()
+
+	o.stateTimeLock.Lock()
+	defer o.stateTimeLock.Unlock()
+
+	o.lastStateChange = now
+	switch dst {
+	case OnuStateEnabled:
+		if o.enabledAt.IsZero() {
+			o.enabledAt = now
+		}
+	case OnuStateCreated, OnuStateDisabled, OnuStatePonDisabled:
+		o.enabledAt = time.Time{}
+	}
+}
+
+// Uptime returns how long the ONU has been up, 0 while it is down
+func (o *Onu) Uptime() time.Duration {
+	o.stateTimeLock.RLock()
+	defer o.stateTimeLock.RUnlock()
+
+	if o.enabledAt.IsZero() {
+		return 0
+	}
+	return deviceClock.Now().Sub(o.enabledAt)
+}
+
+// LastStateChangeTime returns the timestamp of the last InternalState
+// transition, the zero time if the ONU never moved
+func (o *Onu) LastStateChangeTime() time.Time {
+	o.stateTimeLock.RLock()
+	defer o.stateTimeLock.RUnlock()
+
+	return o.lastStateChange
+}
+
 // cleanupOnuState this method is to clean the local state when the ONU is disabled
 func (o *Onu) cleanupOnuState() {
 	// clean the ONU state
@@ -402,9 +864,128 @@ func (o *Onu) cleanupOnuState() {
 	o.onuAlarmsInfoLock.Lock()
 	o.onuAlarmsInfo = make(map[omcilib.OnuAlarmInfoMapKey]omcilib.OnuAlarmInfo) //Basically reset everything on onu disable
 	o.onuAlarmsInfoLock.Unlock()
+
+	o.OmciResponseLatencyMs = 0
 }
 
 // ProcessOnuMessages starts indication channel for each ONU
+// bytes of payload carried by a single FEC codeword, used to derive
+// codeword counts from forwarded traffic
+const fecCodewordSize = 216
+
+// countFecCodewords accounts the FEC codewords carried by a forwarded packet.
+// The corrected count grows with the provisioned error-permit threshold, and
+// BIP errors injected on the PON port surface as uncorrectable codewords.
+func (o *Onu) countFecCodewords(pktLen int) {
+	codewords := uint64(pktLen/fecCodewordSize) + 1
+	atomic.AddUint64(&o.FecCodewords, codewords)
+
+	olt := o.PonPort.Olt
+	if permit := olt.getErrorPermit(); permit > 0 {
+		corrected := codewords * uint64(permit) / 100
+		if corrected == 0 {
+			corrected = 1
+		}
+		if olt.getFecDecMode() != 0 {
+			atomic.AddUint64(&o.FecCodewordsCorrected, corrected)
+		} else {
+			// with FEC decoding off nothing gets corrected, the same
+			// errors surface as uncorrectable codewords
+			atomic.AddUint64(&o.FecCodewordsUncorrectable, corrected)
+		}
+	}
+	if errors, ok := olt.injectedBipErrorsFor(o.PonPortID); ok && errors > 0 {
+		atomic.AddUint64(&o.FecCodewordsUncorrectable, 1)
+	}
+}
+
+// onuChannelDepth returns the configured capacity of the per-ONU message
+// channel, falling back to the historical default when no config is loaded
+// (e.g. in tests)
+func onuChannelDepth() uint32 {
+	if common.Config != nil && common.Config.Olt.OmciChannelDepth > 0 {
+		return common.Config.Olt.OmciChannelDepth
+	}
+	return 2048
+}
+
+// sendMessage queues a message for ProcessOnuMessages. The Channel itself is
+// owned by the ONU and survives reboots, but its reader only runs while the
+// OLT enable context is alive: when that context is missing or already
+// canceled (e.g. while the OLT reboots) the message is refused with an error
+// so callers get a bounded failure instead of blocking on a channel nobody
+// drains anymore.
+func (o *Onu) sendMessage(msg bbsim.Message) error {
+	if o.PonPort == nil || o.PonPort.Olt == nil {
+		return fmt.Errorf("onu-%s-message-refused-no-olt", o.Sn())
+	}
+	ctx := o.PonPort.Olt.enableContext
+	if ctx == nil || ctx.Err() != nil {
+		return fmt.Errorf("onu-%s-message-refused-olt-not-enabled", o.Sn())
+	}
+	select {
+	case o.Channel <- msg:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("onu-%s-message-refused-olt-shutting-down", o.Sn())
+	}
+}
+
+// flushChannel drops every message still queued on the ONU channel, invoked
+// on OLT reboot once the enable context (and with it the channel reader) is
+// gone so the next enable starts clean
+func (o *Onu) flushChannel() {
+	for {
+		select {
+		case <-o.Channel:
+		default:
+			return
+		}
+	}
+}
+
+// enqueueOmciMessage pushes an OMCI message on the ONU channel without
+// blocking: when the channel is full the message is dropped and counted, so a
+// slow ONU cannot stall the gRPC handler serving all the others
+func (o *Onu) enqueueOmciMessage(msg bbsim.Message) error {
+	select {
+	case o.Channel <- msg:
+		if omciMsg, ok := msg.Data.(bbsim.OmciMessage); ok && omciMsg.OmciMsg != nil {
+			o.pendingOmciLock.Lock()
+			o.pendingOmciTypes = append(o.pendingOmciTypes, omciMsg.OmciMsg.MessageType.String())
+			o.pendingOmciLock.Unlock()
+		}
+		return nil
+	default:
+		drops := atomic.AddUint64(&o.OmciChannelDrops, 1)
+		onuLogger.WithFields(log.Fields{
+			"IntfId": o.PonPortID,
+			"OnuId":  o.ID,
+			"OnuSn":  o.Sn(),
+			"Drops":  drops,
+		}).Warn("dropping-omci-message-onu-channel-full")
+		return fmt.Errorf("onu-%s-channel-full", o.Sn())
+	}
+}
+
+// popPendingOmciType forgets the oldest tracked OMCI message type once the
+// corresponding request is picked up by ProcessOnuMessages
+func (o *Onu) popPendingOmciType() {
+	o.pendingOmciLock.Lock()
+	if len(o.pendingOmciTypes) > 0 {
+		o.pendingOmciTypes = o.pendingOmciTypes[1:]
+	}
+	o.pendingOmciLock.Unlock()
+}
+
+// PendingOmciTypes returns a copy of the decoded types of the OMCI requests
+// currently queued on the ONU channel
+func (o *Onu) PendingOmciTypes() []string {
+	o.pendingOmciLock.Lock()
+	defer o.pendingOmciLock.Unlock()
+	return append([]string{}, o.pendingOmciTypes...)
+}
+
 func (o *Onu) ProcessOnuMessages(ctx context.Context, stream openolt.Openolt_EnableIndicationServer, client openolt.OpenoltClient) {
 	onuLogger.WithFields(log.Fields{
 		"onuID":   o.ID,
@@ -452,7 +1033,7 @@ loop:
 			case bbsim.OnuDiscIndication:
 				msg, _ := message.Data.(bbsim.OnuDiscIndicationMessage)
 				// NOTE we need to slow down and send ONU Discovery Indication in batches to better emulate a real scenario
-				time.Sleep(o.DiscoveryDelay)
+				deviceClock.Sleep(o.DiscoveryDelay)
 				o.sendOnuDiscIndication(msg, stream)
 			case bbsim.OnuIndication:
 				msg, _ := message.Data.(bbsim.OnuIndicationMessage)
@@ -460,6 +1041,7 @@ loop:
 			case bbsim.OMCI:
 				// these are OMCI messages received by the ONU
 				msg, _ := message.Data.(bbsim.OmciMessage)
+				o.popPendingOmciType()
 				_ = o.handleOmciRequest(msg, stream)
 			case bbsim.UniStatusAlarm:
 				msg, _ := message.Data.(bbsim.UniStatusAlarmMessage)
@@ -497,6 +1079,44 @@ loop:
 					}
 				}
 				o.onuAlarmsInfoLock.Unlock()
+			case bbsim.MeAlarm:
+				msg, _ := message.Data.(bbsim.MeAlarmMessage)
+				meAlarmMapKey := omcilib.OnuAlarmInfoMapKey{
+					MeInstance: msg.EntityID,
+					MeClassID:  me.ClassID(msg.EntityClass),
+				}
+				seqNo := o.IncrementAlarmSequenceNumber(meAlarmMapKey)
+				o.onuAlarmsInfoLock.Lock()
+				var meAlarmInfo = o.onuAlarmsInfo[meAlarmMapKey]
+				pkt, alarmBitMap := omcilib.CreateMeAlarm(msg.Raise, me.ClassID(msg.EntityClass), msg.EntityID, msg.AlarmBit, seqNo, meAlarmInfo.AlarmBitMap)
+				if pkt != nil { //pkt will be nil if we are unable to create the alarm
+					if err := o.sendOmciIndication(pkt, 0, stream); err != nil {
+						onuLogger.WithFields(log.Fields{
+							"IntfId":       o.PonPortID,
+							"SerialNumber": o.Sn(),
+							"omciPacket":   pkt,
+							"meClassID":    msg.EntityClass,
+							"entityID":     msg.EntityID,
+							"alarmBit":     msg.AlarmBit,
+						}).Errorf("failed-to-send-ME-Alarm: %v", err)
+						meAlarmInfo.SequenceNo--
+					}
+					onuLogger.WithFields(log.Fields{
+						"IntfId":       o.PonPortID,
+						"SerialNumber": o.Sn(),
+						"omciPacket":   pkt,
+						"meClassID":    msg.EntityClass,
+						"entityID":     msg.EntityID,
+						"alarmBit":     msg.AlarmBit,
+					}).Debug("ME-alarm-sent")
+					if alarmBitMap == [28]byte{0} {
+						delete(o.onuAlarmsInfo, meAlarmMapKey)
+					} else {
+						meAlarmInfo.AlarmBitMap = alarmBitMap
+						o.onuAlarmsInfo[meAlarmMapKey] = meAlarmInfo
+					}
+				}
+				o.onuAlarmsInfoLock.Unlock()
 			case bbsim.FlowAdd:
 				msg, _ := message.Data.(bbsim.OnuFlowUpdateMessage)
 				o.handleFlowAdd(msg)
@@ -533,6 +1153,7 @@ loop:
 					}).Error("Cannot find Uni associated with packet")
 					return
 				}
+				o.countFecCodewords(len(msg.Packet.Data()))
 				uni.PacketCh <- msg
 			// BBR specific messages
 			case bbsim.OnuPacketIn:
@@ -593,26 +1214,44 @@ func NewSN(oltid int, intfid uint32, onuid uint32) *openolt.SerialNumber {
 }
 
 func (o *Onu) sendOnuDiscIndication(msg bbsim.OnuDiscIndicationMessage, stream openolt.Openolt_EnableIndicationServer) {
-	discoverData := &openolt.Indication_OnuDiscInd{OnuDiscInd: &openolt.OnuDiscIndication{
-		IntfId:       o.PonPortID,
-		SerialNumber: o.SerialNumber,
-	}}
-
-	if err := stream.Send(&openolt.Indication{Data: discoverData}); err != nil {
-		log.Errorf("Failed to send Indication_OnuDiscInd: %v", err)
+	if stream == nil {
+		onuLogger.WithFields(log.Fields{
+			"IntfId": o.PonPortID,
+			"OnuSn":  o.Sn(),
+		}).Warn("Dropping Indication_OnuDiscInd: no openolt stream")
 		return
 	}
+	if o.PonPort.discoveryFails() {
+		// this attempt fails: nothing is sent upstream, the ONU stays in
+		// discovered state and the retry timer below fires a new attempt
+		onuLogger.WithFields(log.Fields{
+			"IntfId": o.PonPortID,
+			"OnuSn":  o.Sn(),
+			"OnuId":  o.ID,
+		}).Warn("Simulating ONU discovery failure")
+		publishEvent("ONU-discovery-failure-simulated", int32(o.PonPortID), int32(o.ID), o.Sn())
+	} else {
+		discoverData := &openolt.Indication_OnuDiscInd{OnuDiscInd: &openolt.OnuDiscIndication{
+			IntfId:       o.PonPortID,
+			SerialNumber: o.SerialNumber,
+		}}
 
-	onuLogger.WithFields(log.Fields{
-		"IntfId": o.PonPortID,
-		"OnuSn":  o.Sn(),
-		"OnuId":  o.ID,
-	}).Debug("Sent Indication_OnuDiscInd")
-	publishEvent("ONU-discovery-indication-sent", int32(o.PonPortID), int32(o.ID), o.Sn())
+		if err := stream.Send(&openolt.Indication{Data: discoverData}); err != nil {
+			log.Errorf("Failed to send Indication_OnuDiscInd: %v", err)
+			return
+		}
+
+		onuLogger.WithFields(log.Fields{
+			"IntfId": o.PonPortID,
+			"OnuSn":  o.Sn(),
+			"OnuId":  o.ID,
+		}).Debug("Sent Indication_OnuDiscInd")
+		publishEvent("ONU-discovery-indication-sent", int32(o.PonPortID), int32(o.ID), o.Sn())
+	}
 
 	// after DiscoveryRetryDelay check if the state is the same and in case send a new OnuDiscIndication
 	go func(delay time.Duration) {
-		time.Sleep(delay)
+		deviceClock.Sleep(delay)
 		if o.InternalState.Current() == OnuStateDiscovered {
 			o.sendOnuDiscIndication(msg, stream)
 		}
@@ -620,6 +1259,13 @@ func (o *Onu) sendOnuDiscIndication(msg bbsim.OnuDiscIndicationMessage, stream o
 }
 
 func (o *Onu) sendOnuIndication(msg bbsim.OnuIndicationMessage, stream openolt.Openolt_EnableIndicationServer) {
+	if stream == nil {
+		onuLogger.WithFields(log.Fields{
+			"IntfId": o.PonPortID,
+			"OnuSn":  o.Sn(),
+		}).Warn("Dropping Indication_OnuInd: no openolt stream")
+		return
+	}
 	// NOTE the ONU ID is set by VOLTHA in the ActivateOnu call (via openolt.proto)
 	// and stored in the Onu struct via onu.SetID
 
@@ -693,6 +1339,107 @@ func (o *Onu) HandleShutdownONU() error {
 	return nil
 }
 
+// HandlePowerLossONU simulates the ONU unexpectedly losing power: it emits a
+// dying-gasp alarm and then goes down, optionally after a delay. Unlike an
+// admin disable no LOS is raised; the ONU can be recovered with a PowerOn
+func (o *Onu) HandlePowerLossONU(delay time.Duration) error {
+
+	if delay > 0 {
+		deviceClock.Sleep(delay)
+	}
+
+	dyingGasp := pb.ONUAlarmRequest{
+		AlarmType:    "DYING_GASP",
+		SerialNumber: o.Sn(),
+		Status:       "on",
+	}
+
+	if err := alarmsim.SimulateOnuAlarm(&dyingGasp, o.ID, o.PonPortID, o.PonPort.Olt.channel); err != nil {
+		onuLogger.WithFields(log.Fields{
+			"OnuId":  o.ID,
+			"IntfId": o.PonPortID,
+			"OnuSn":  o.Sn(),
+		}).Errorf("Cannot send Dying Gasp: %s", err.Error())
+		return err
+	}
+
+	if err := o.InternalState.Event(OnuTxDisable); err != nil {
+		onuLogger.WithFields(log.Fields{
+			"OnuId":  o.ID,
+			"IntfId": o.PonPortID,
+			"OnuSn":  o.Sn(),
+		}).Errorf("Cannot power off ONU: %s", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// RebootOnu simulates a firmware-style reboot of a single ONU: it goes down
+// as in a power loss (dying gasp, indications, OMCI state dropped), stays
+// down for the given delay and then powers back on, re-running discovery and
+// the OMCI bring-up. Unlike a disable the ONU comes back by itself; the
+// pending power on can be aborted with CancelOnuReboot
+func (o *Onu) RebootOnu(delay time.Duration) error {
+	o.rebootLock.Lock()
+	if o.rebootCancel != nil {
+		o.rebootLock.Unlock()
+		return fmt.Errorf("onu-%s-is-already-rebooting", o.Sn())
+	}
+	cancel := make(chan struct{})
+	o.rebootCancel = cancel
+	o.rebootLock.Unlock()
+
+	if err := o.HandlePowerLossONU(0); err != nil {
+		o.rebootLock.Lock()
+		o.rebootCancel = nil
+		o.rebootLock.Unlock()
+		return err
+	}
+
+	go func() {
+		select {
+		case <-cancel:
+			onuLogger.WithFields(log.Fields{
+				"OnuId":  o.ID,
+				"IntfId": o.PonPortID,
+				"OnuSn":  o.Sn(),
+			}).Info("onu-reboot-canceled-before-power-on")
+			return
+		case <-deviceClock.After(delay):
+		}
+
+		o.rebootLock.Lock()
+		o.rebootCancel = nil
+		o.rebootLock.Unlock()
+
+		if err := o.HandlePowerOnONU(); err != nil {
+			onuLogger.WithFields(log.Fields{
+				"OnuId":  o.ID,
+				"IntfId": o.PonPortID,
+				"OnuSn":  o.Sn(),
+			}).Errorf("Cannot power on ONU after reboot: %s", err.Error())
+		}
+	}()
+
+	return nil
+}
+
+// CancelOnuReboot aborts a pending reboot, leaving the ONU down as if it
+// had been disabled
+func (o *Onu) CancelOnuReboot() error {
+	o.rebootLock.Lock()
+	defer o.rebootLock.Unlock()
+
+	if o.rebootCancel == nil {
+		return fmt.Errorf("onu-%s-has-no-reboot-in-progress", o.Sn())
+	}
+
+	close(o.rebootCancel)
+	o.rebootCancel = nil
+	return nil
+}
+
 func (o *Onu) HandlePowerOnONU() error {
 	intitalState := o.InternalState.Current()
 
@@ -771,6 +1518,48 @@ func (o *Onu) SetAlarm(alarmType string, status string) error {
 	return nil
 }
 
+// vendorId returns the vendor id reported for this ONU, falling back to the
+// default so ONUs built outside CreateONU (e.g. in tests) are covered too
+func (o *Onu) vendorId() string {
+	if o.VendorId == "" {
+		return defaultOnuVendorId
+	}
+	return o.VendorId
+}
+
+// SetDistance changes the simulated fiber distance of the ONU, as if the
+// subscriber had been physically moved, and re-ranges it against the PON's
+// optical budget. If the new distance is out of budget a LOS alarm is raised.
+func (o *Onu) SetDistance(distanceKm uint32) error {
+	oldDistance := o.DistanceKm
+	o.DistanceKm = distanceKm
+
+	onuLogger.WithFields(log.Fields{
+		"IntfId":        o.PonPortID,
+		"OnuId":         o.ID,
+		"OnuSn":         o.Sn(),
+		"OldDistanceKm": oldDistance,
+		"DistanceKm":    distanceKm,
+	}).Info("Re-ranging ONU after distance change")
+	publishEvent("ONU-re-ranged", int32(o.PonPortID), int32(o.ID), o.Sn())
+
+	if err := o.PonPort.checkOpticalBudget(o); err != nil {
+		onuLogger.WithFields(log.Fields{
+			"OnuSn":      o.Sn(),
+			"DistanceKm": distanceKm,
+			"err":        err,
+		}).Warn("ONU re-ranged out of the PON optical budget")
+		if alarmErr := o.SetAlarm("ONU_ALARM_LOS", "on"); alarmErr != nil {
+			onuLogger.WithFields(log.Fields{
+				"OnuSn": o.Sn(),
+				"err":   alarmErr,
+			}).Error("Cannot raise LOS alarm for out-of-budget ONU")
+		}
+		return err
+	}
+	return nil
+}
+
 func (o *Onu) publishOmciEvent(msg bbsim.OmciMessage) {
 	if olt.PublishEvents {
 		_, omciMsg, err := omcilib.ParseOpenOltOmciPacket(msg.OmciPkt.Data())
@@ -792,7 +1581,25 @@ func (o *Onu) publishOmciEvent(msg bbsim.OmciMessage) {
 
 // handleOmciRequest is responsible to parse the OMCI packets received from the openolt adapter
 // and generate the appropriate response to it
-func (o *Onu) handleOmciRequest(msg bbsim.OmciMessage, stream openolt.Openolt_EnableIndicationServer) error {
+func (o *Onu) handleOmciRequest(msg bbsim.OmciMessage, stream openolt.Openolt_EnableIndicationServer) (err error) {
+
+	// a malformed OMCI message must not take down the whole simulator, recover
+	// from panics in the parsing/ME handling and keep the ONU processing loop alive
+	defer func() {
+		if r := recover(); r != nil {
+			fields := log.Fields{
+				"IntfId":       o.PonPortID,
+				"OnuId":        o.ID,
+				"SerialNumber": o.Sn(),
+				"panic":        r,
+			}
+			if msg.OmciPkt != nil {
+				fields["omciPacket"] = hex.EncodeToString(msg.OmciPkt.Data())
+			}
+			onuLogger.WithFields(fields).Error("recovered-from-panic-while-handling-omci-message")
+			err = fmt.Errorf("panic-while-handling-omci-message: %v", r)
+		}
+	}()
 
 	onuLogger.WithFields(log.Fields{
 		"omciMsgType":  msg.OmciMsg.MessageType,
@@ -802,6 +1609,22 @@ func (o *Onu) handleOmciRequest(msg bbsim.OmciMessage, stream openolt.Openolt_En
 		"SerialNumber": o.Sn(),
 	}).Debug("omci-message-decoded")
 
+	if fault, ok := o.getOmciFault(msg.OmciMsg.MessageType); ok {
+		if fault.DelayMs > 0 {
+			deviceClock.Sleep(time.Duration(fault.DelayMs) * time.Millisecond)
+		}
+		if fault.Drop {
+			onuLogger.WithFields(log.Fields{
+				"IntfId":       o.PonPortID,
+				"OnuId":        o.ID,
+				"SerialNumber": o.Sn(),
+				"omciMsgType":  msg.OmciMsg.MessageType,
+				"txId":         msg.OmciMsg.TransactionID,
+			}).Warn("dropping-omci-msg-because-of-injected-fault")
+			return fmt.Errorf("dropping-omci-msg-because-of-injected-fault")
+		}
+	}
+
 	if o.OmciMsgCounter < maxOmciMsgCounter {
 		o.OmciMsgCounter++
 	} else {
@@ -835,15 +1658,102 @@ func (o *Onu) handleOmciRequest(msg bbsim.OmciMessage, stream openolt.Openolt_En
 	case omci.MibUploadRequestType:
 		responsePkt, _ = omcilib.CreateMibUploadResponse(msg.OmciMsg, o.MibDb)
 	case omci.MibUploadNextRequestType:
-		responsePkt, _ = omcilib.CreateMibUploadNextResponse(msg.OmciPkt, msg.OmciMsg, o.MibDb)
+		responsePkt, _ = omcilib.CreateMibUploadNextResponse(msg.OmciPkt, msg.OmciMsg, o.MibDb, o.MibDataSync)
 	case omci.GetRequestType:
+		if getObj, err := omcilib.ParseGetRequest(msg.OmciPkt); err == nil {
+			if !o.isMeSupported(getObj.EntityClass) {
+				onuLogger.WithFields(log.Fields{
+					"IntfId":       o.PonPortID,
+					"OnuId":        o.ID,
+					"SerialNumber": o.Sn(),
+					"MeClassID":    getObj.EntityClass,
+				}).Debug("get-request-for-unsupported-me")
+				responsePkt, _ = omcilib.CreateGetUnsupportedMeResponse(msg.OmciPkt, msg.OmciMsg)
+				break
+			}
+			// a Get on a table attribute latches a snapshot of the table and
+			// returns its size, the rows are then read through GetNext
+			if attr := omcilib.TableAttributeDefinition(getObj.EntityClass, getObj.EntityInstance, getObj.AttributeMask); attr != nil {
+				var table []byte
+				if getObj.EntityClass == me.MulticastSubscriberMonitorClassID {
+					// the active group list reflects the groups this ONU joined
+					_, _, groups := o.mcastState()
+					table = omcilib.BuildIpv4ActiveGroupListTable(groups)
+				} else {
+					table = omcilib.GenerateTableData(getObj.EntityClass, getObj.EntityInstance, getObj.AttributeMask)
+				}
+				o.latchOmciTable(omciTableKey{getObj.EntityClass, getObj.EntityInstance, getObj.AttributeMask}, table)
+				onuLogger.WithFields(log.Fields{
+					"IntfId":       o.PonPortID,
+					"OnuId":        o.ID,
+					"SerialNumber": o.Sn(),
+					"MeClassID":    getObj.EntityClass,
+					"Attribute":    attr.GetName(),
+					"TableBytes":   len(table),
+				}).Debug("latched-table-attribute-snapshot")
+				responsePkt, _ = omcilib.CreateGetTableSizeResponse(msg.OmciPkt, msg.OmciMsg, uint32(len(table)))
+				break
+			}
+			if getObj.EntityClass == me.MulticastSubscriberMonitorClassID {
+				joins, bwExceeded, groups := o.mcastState()
+				responsePkt, _ = omcilib.CreateMcastSubscriberMonitorResponse(msg.OmciPkt, msg.OmciMsg, joins, bwExceeded, uint32(len(groups))*mcastGroupBandwidth)
+				break
+			}
+			if getObj.EntityClass == me.Aal5PerformanceMonitoringHistoryDataClassID {
+				counters, intervalEndTime := o.aal5PmState()
+				responsePkt, _ = omcilib.CreateAal5PmGetResponse(msg.OmciPkt, msg.OmciMsg, intervalEndTime, counters)
+				break
+			}
+			if getObj.EntityClass == me.OctetStringClassID {
+				length, data, exists := o.getOctetString(getObj.EntityInstance)
+				responsePkt, _ = omcilib.CreateOctetStringResponse(msg.OmciPkt, msg.OmciMsg, exists, length, data)
+				break
+			}
+		}
 		onuDown := o.AdminLockState == 1
 		responsePkt, _ = omcilib.CreateGetResponse(msg.OmciPkt, msg.OmciMsg, o.SerialNumber, o.MibDataSync, o.ActiveImageEntityId,
 			o.CommittedImageEntityId, o.StandbyImageVersion, o.ActiveImageVersion, o.CommittedImageVersion, onuDown)
 
+	case omci.GetCurrentDataRequestType:
+		if currObj, err := omcilib.ParseGetCurrentDataRequest(msg.OmciPkt); err == nil {
+			if currObj.EntityClass == me.Aal5PerformanceMonitoringHistoryDataClassID {
+				counters, intervalEndTime := o.aal5PmState()
+				responsePkt, _ = omcilib.CreateAal5PmGetCurrentDataResponse(msg.OmciPkt, msg.OmciMsg, intervalEndTime, counters)
+			} else {
+				onuLogger.WithFields(log.Fields{
+					"IntfId":       o.PonPortID,
+					"OnuId":        o.ID,
+					"SerialNumber": o.Sn(),
+					"MeClassID":    currObj.EntityClass,
+				}).Warn("get-current-data-not-supported-for-me-class")
+			}
+		}
+	case omci.GetNextRequestType:
+		if getNextObj, err := omcilib.ParseGetNextRequest(msg.OmciPkt); err == nil {
+			snapshot := o.omciTableSnapshot(omciTableKey{getNextObj.EntityClass, getNextObj.EntityInstance, getNextObj.AttributeMask})
+			if snapshot == nil {
+				onuLogger.WithFields(log.Fields{
+					"IntfId":       o.PonPortID,
+					"OnuId":        o.ID,
+					"SerialNumber": o.Sn(),
+					"MeClassID":    getNextObj.EntityClass,
+				}).Warn("get-next-without-a-preceding-get")
+			}
+			responsePkt, _ = omcilib.CreateGetNextResponse(msg.OmciPkt, msg.OmciMsg, snapshot)
+		}
 	case omci.SetRequestType:
 		success := true
 		msgObj, _ := omcilib.ParseSetRequest(msg.OmciPkt)
+		if !o.isMeSupported(msgObj.EntityClass) {
+			onuLogger.WithFields(log.Fields{
+				"IntfId":       o.PonPortID,
+				"OnuId":        o.ID,
+				"SerialNumber": o.Sn(),
+				"MeClassID":    msgObj.EntityClass,
+			}).Debug("set-request-for-unsupported-me")
+			responsePkt, _ = omcilib.CreateSetResponse(msg.OmciPkt, msg.OmciMsg, me.UnknownEntity)
+			break
+		}
 		switch msgObj.EntityClass {
 		case me.PhysicalPathTerminationPointEthernetUniClassID:
 			// if we're Setting a PPTP state
@@ -942,6 +1852,16 @@ func (o *Onu) handleOmciRequest(msg bbsim.OmciMessage, stream openolt.Openolt_En
 					o.PonPort.storeAllocId(o.PonPortID, o.ID, entityID, allocId, o.SerialNumber)
 				}
 			}
+		case me.OctetStringClassID:
+			if !o.setOctetString(msgObj.EntityInstance, msgObj.Attributes) {
+				onuLogger.WithFields(log.Fields{
+					"IntfId":       o.PonPortID,
+					"OnuId":        o.ID,
+					"EntityID":     msgObj.EntityInstance,
+					"SerialNumber": o.Sn(),
+				}).Warn("cannot-set-octet-string-instance")
+				success = false
+			}
 		case me.EthernetFrameExtendedPmClassID,
 			me.EthernetFrameExtendedPm64BitClassID:
 			onuLogger.WithFields(log.Fields{
@@ -966,6 +1886,17 @@ func (o *Onu) handleOmciRequest(msg bbsim.OmciMessage, stream openolt.Openolt_En
 		var sn *openolt.SerialNumber
 		msgObj, err := omcilib.ParseCreateRequest(msg.OmciPkt)
 		if err == nil {
+			if msgObj.EntityClass == me.OctetStringClassID {
+				if !o.createOctetString(msgObj.EntityInstance) {
+					onuLogger.WithFields(log.Fields{
+						"IntfId":       o.PonPortID,
+						"OnuId":        o.ID,
+						"EntityID":     msgObj.EntityInstance,
+						"SerialNumber": o.Sn(),
+					}).Error("octet-string-instance-already-exists")
+					used = true
+				}
+			}
 			if msgObj.EntityClass == me.GemPortNetworkCtpClassID {
 				// GemPort 4069 is reserved for multicast and shared across ONUs
 				if msgObj.EntityInstance != 4069 {
@@ -989,10 +1920,8 @@ func (o *Onu) handleOmciRequest(msg bbsim.OmciMessage, stream openolt.Openolt_En
 			}
 		}
 
-		// if the gemPort is valid then increment the MDS and return a successful response
-		// otherwise fail the request
-		// for now the CreateRequeste for the gemPort is the only one that can fail, if we start supporting multiple
-		// validation this check will need to be rewritten
+		// if the gemPort (or OctetString instance) is valid then increment the MDS
+		// and return a successful response, otherwise fail the request
 		if !used {
 			if responsePkt, errResp = omcilib.CreateCreateResponse(msg.OmciPkt, msg.OmciMsg, me.Success); errResp == nil {
 				o.MibDataSync++
@@ -1003,6 +1932,11 @@ func (o *Onu) handleOmciRequest(msg bbsim.OmciMessage, stream openolt.Openolt_En
 	case omci.DeleteRequestType:
 		msgObj, err := omcilib.ParseDeleteRequest(msg.OmciPkt)
 		if err == nil {
+			if msgObj.EntityClass == me.OctetStringClassID {
+				// per G.988 dangling references to a deleted OctetString are
+				// the OLT's responsibility, the ONU just drops the instance
+				o.deleteOctetString(msgObj.EntityInstance)
+			}
 			if msgObj.EntityClass == me.GemPortNetworkCtpClassID {
 				onuLogger.WithFields(log.Fields{
 					"IntfId":       o.PonPortID,
@@ -1045,7 +1979,7 @@ func (o *Onu) handleOmciRequest(msg bbsim.OmciMessage, stream openolt.Openolt_En
 				// send test results asynchronously
 				go func() {
 					// Send test results after a second to emulate async behavior
-					time.Sleep(1 * time.Second)
+					deviceClock.Sleep(1 * time.Second)
 					if testResultPkt != nil {
 						if err := o.sendOmciIndication(testResultPkt, msg.OmciMsg.TransactionID, stream); err != nil {
 							onuLogger.WithFields(log.Fields{
@@ -1273,6 +2207,9 @@ func (o *Onu) handleOmciRequest(msg bbsim.OmciMessage, stream openolt.Openolt_En
 	}
 
 	if responsePkt != nil {
+		if o.OmciResponseLatencyMs > 0 {
+			deviceClock.Sleep(time.Duration(o.OmciResponseLatencyMs) * time.Millisecond)
+		}
 		if err := o.sendOmciIndication(responsePkt, msg.OmciMsg.TransactionID, stream); err != nil {
 			onuLogger.WithFields(log.Fields{
 				"IntfId":          o.PonPortID,
@@ -1485,7 +2422,7 @@ func (o *Onu) Reboot(timeout time.Duration) error {
 	if err := o.HandleShutdownONU(); err != nil {
 		return err
 	}
-	time.Sleep(timeout)
+	deviceClock.Sleep(timeout)
 	onuLogger.WithFields(log.Fields{
 		"IntfId":       o.PonPortID,
 		"OnuId":        o.ID,
@@ -1811,13 +2748,28 @@ func (onu *Onu) DeleteFlow(key FlowKey) {
 	}
 }
 
+// rediscoveryDelay returns the configured pacing between ONU re-discoveries,
+// falling back to no pacing when no config is loaded (e.g. in tests)
+func rediscoveryDelay() time.Duration {
+	if common.Config != nil && common.Config.BBSim.RediscoveryDelay > 0 {
+		return time.Duration(common.Config.BBSim.RediscoveryDelay) * time.Millisecond
+	}
+	return 0
+}
+
 /* when ReDiscoverOnu is called during reboot, true is passed so that there is no delay in onu discoveries
-   It is assumed that all onu resources are cleared and no sleep is required
+   It is assumed that all onu resources are cleared and no sleep is required.
+   Both paths are additionally paced by the configured rediscovery_delay so a
+   large PON does not flood the stream with re-discoveries at once
 */
 func (onu *Onu) ReDiscoverOnu(isReboot bool) {
 	// Wait for few seconds to be sure of the cleanup
 	if !isReboot {
-		time.Sleep(5 * time.Second)
+		deviceClock.Sleep(5 * time.Second)
+	}
+
+	if delay := rediscoveryDelay(); delay > 0 {
+		deviceClock.Sleep(delay)
 	}
 
 	onuLogger.WithFields(log.Fields{
@@ -1844,6 +2796,82 @@ func (onu *Onu) ReDiscoverOnu(isReboot bool) {
 	}
 }
 
+// StartRxPowerDrift linearly drifts the rx-power of the ONU from its current
+// value to target over the given duration, updating the level once per second.
+// When the level crosses threshold going down an ONU_SIGNAL_DEGRADE alarm is
+// raised. A drift already in progress is canceled before the new one starts.
+func (onu *Onu) StartRxPowerDrift(target float64, duration time.Duration, threshold float64) {
+	onu.CancelRxPowerDrift()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	onu.rxPowerDriftCancel = cancel
+
+	start := onu.RxPower
+	steps := int(duration.Seconds())
+	if steps < 1 {
+		steps = 1
+	}
+	stepSize := (target - start) / float64(steps)
+
+	onuLogger.WithFields(log.Fields{
+		"IntfId":    onu.PonPortID,
+		"OnuId":     onu.ID,
+		"OnuSn":     onu.Sn(),
+		"Target":    target,
+		"Duration":  duration,
+		"Threshold": threshold,
+	}).Info("Starting ONU rx-power drift")
+
+	go func() {
+		for i := 1; i <= steps; i++ {
+			select {
+			case <-deviceClock.After(1 * time.Second):
+				previous := onu.RxPower
+				onu.RxPower = start + stepSize*float64(i)
+
+				if previous > threshold && onu.RxPower <= threshold {
+					onuLogger.WithFields(log.Fields{
+						"IntfId":  onu.PonPortID,
+						"OnuId":   onu.ID,
+						"OnuSn":   onu.Sn(),
+						"RxPower": onu.RxPower,
+					}).Warn("ONU rx-power crossed the degradation threshold")
+
+					alarmReq := pb.ONUAlarmRequest{
+						AlarmType:    "ONU_SIGNAL_DEGRADE",
+						SerialNumber: onu.Sn(),
+						Status:       "on",
+					}
+
+					if err := alarmsim.SimulateOnuAlarm(&alarmReq, onu.ID, onu.PonPortID, onu.PonPort.Olt.channel); err != nil {
+						onuLogger.WithFields(log.Fields{
+							"OnuId":  onu.ID,
+							"IntfId": onu.PonPortID,
+							"OnuSn":  onu.Sn(),
+						}).Errorf("Cannot send ONU_SIGNAL_DEGRADE: %s", err.Error())
+					}
+				}
+			case <-ctx.Done():
+				onuLogger.WithFields(log.Fields{
+					"IntfId": onu.PonPortID,
+					"OnuId":  onu.ID,
+					"OnuSn":  onu.Sn(),
+				}).Debug("ONU rx-power drift canceled")
+				return
+			}
+		}
+	}()
+}
+
+// CancelRxPowerDrift stops an rx-power drift in progress, the rx-power keeps
+// the value it drifted to so far
+func (onu *Onu) CancelRxPowerDrift() {
+	if onu.rxPowerDriftCancel != nil {
+		onu.rxPowerDriftCancel()
+		onu.rxPowerDriftCancel = nil
+	}
+}
+
 // deprecated, delegate this to the uniPort
 func (onu *Onu) findServiceByMacAddress(macAddress net.HardwareAddr) (*Service, error) {
 	// FIXME is there a better way to avoid this loop?
@@ -1907,7 +2935,7 @@ func (o *Onu) IncrementAlarmSequenceNumber(key omcilib.OnuAlarmInfoMapKey) uint8
 }
 
 func (o *Onu) InvalidateMibDataSync() {
-	rand.Seed(time.Now().UnixNano())
+	rand.Seed(deviceClock.Now().UnixNano())
 	r := uint8(rand.Intn(10) + 1)
 
 	o.MibDataSync += r
@@ -23,8 +23,6 @@ import (
 )
 
 func Test_Onu_CreateOnu(t *testing.T) {
-	nextCtag := map[string]int{}
-	nextStag := map[string]int{}
 
 	olt := OltDevice{
 		ID:             0,
@@ -37,7 +35,7 @@ func Test_Onu_CreateOnu(t *testing.T) {
 		Olt: &olt,
 	}
 
-	onu := CreateONU(&olt, &pon, 1, 0, nextCtag, nextStag, false)
+	onu := CreateONU(&olt, &pon, 1, 0, NewTagAllocator(0, 0), false)
 
 	assert.Equal(t, "BBSM00000101", onu.Sn())
 	assert.Equal(t, 4, len(onu.UniPorts))
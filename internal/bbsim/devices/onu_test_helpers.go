@@ -176,8 +176,6 @@ func createMockOnu(id uint32, ponPortId uint32) *Onu {
 
 // this method creates a real ONU to be used in the tests
 func createTestOnu() *Onu {
-	nextCtag := map[string]int{}
-	nextStag := map[string]int{}
 
 	olt := OltDevice{
 		ID:               0,
@@ -186,7 +184,7 @@ func createTestOnu() *Onu {
 
 	pon := CreatePonPort(&olt, 1, bbsim_common.XGSPON)
 
-	onu := CreateONU(&olt, pon, 1, time.Duration(1*time.Millisecond), nextCtag, nextStag, true)
+	onu := CreateONU(&olt, pon, 1, time.Duration(1*time.Millisecond), NewTagAllocator(0, 0), true)
 	// NOTE we need this in order to create the OnuChannel
 	_ = onu.InternalState.Event(OnuTxInitialize)
 	onu.DiscoveryRetryDelay = 100 * time.Millisecond
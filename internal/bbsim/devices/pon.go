@@ -19,7 +19,10 @@ package devices
 import (
 	"bytes"
 	"fmt"
+	"math/rand"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/looplab/fsm"
 	"github.com/opencord/bbsim/internal/common"
@@ -27,7 +30,7 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-var ponLogger = log.WithFields(log.Fields{
+var ponLogger = deviceLogger.WithFields(log.Fields{
 	"module": "PON",
 })
 
@@ -49,8 +52,14 @@ type PonPort struct {
 	NumOnu        int
 	Onus          []*Onu
 	Olt           *OltDevice
-	PacketCount   uint64
-	InternalState *fsm.FSM
+	PacketCount    uint64
+	Latency        time.Duration // artificial delay applied to data-plane packets on this PON
+	Jitter         time.Duration // random additional delay in [0, Jitter) applied on top of Latency
+	// probability in [0,1] that a single ONU discovery attempt on this PON fails
+	DiscoveryFailureRate float64
+	PacketLossRate float64       // probability in [0,1] that a data-plane packet on this PON is dropped
+	DroppedPackets uint64        // number of data-plane packets dropped by the loss injection, incremented atomically
+	InternalState  *fsm.FSM
 
 	// PON Attributes
 	OperState *fsm.FSM
@@ -107,8 +116,21 @@ func CreatePonPort(olt *OltDevice, id uint32, tech common.PonTechnology) *PonPor
 					}
 				} else if e.Src == "disabled" {
 					if ponPort.Olt.ControlledActivation == OnlyONU || ponPort.Olt.ControlledActivation == Both {
-						// if ONUs are manually activated then only initialize them
+						// if ONUs are manually activated then only initialize them,
+						// unless they were enabled when the OLT was disabled, in which
+						// case restore them straight to enabled so the disable/re-enable
+						// cycle is transparent to active subscribers
 						for _, onu := range ponPort.Onus {
+							if onu.InternalState.Current() == OnuStatePonDisabled && ponPort.Olt.wasActiveBeforeDisable(onu.Sn()) {
+								if err := onu.InternalState.Event(OnuTxEnable); err != nil {
+									ponLogger.WithFields(log.Fields{
+										"Err":    err,
+										"OnuSn":  onu.Sn(),
+										"IntfId": onu.PonPortID,
+									}).Error("Error restoring ONU")
+								}
+								continue
+							}
 							if err := onu.InternalState.Event(OnuTxInitialize); err != nil {
 								ponLogger.WithFields(log.Fields{
 									"Err":    err,
@@ -219,6 +241,57 @@ func (p *PonPort) GetOnuById(id uint32) (*Onu, error) {
 	return nil, fmt.Errorf("Cannot find Onu with id %d in PonPort %d", id, p.ID)
 }
 
+// dropPacket returns whether a data-plane packet has to be dropped according
+// to the configured loss probability, updating the dropped packets counter
+func (p *PonPort) dropPacket() bool {
+	if p.PacketLossRate <= 0 {
+		return false
+	}
+	if rand.Float64() >= p.PacketLossRate {
+		return false
+	}
+	atomic.AddUint64(&p.DroppedPackets, 1)
+	return true
+}
+
+// discoveryFails returns whether an ONU discovery attempt on this PON has to
+// fail according to the configured per-attempt failure probability
+func (p *PonPort) discoveryFails() bool {
+	if p.DiscoveryFailureRate <= 0 {
+		return false
+	}
+	return rand.Float64() < p.DiscoveryFailureRate
+}
+
+// checkOpticalBudget evaluates the simulated optical budget of this PON for
+// an ONU: the configured fiber distance must stay within max_distance_km and
+// the receive power above rx_sensitivity_dbm. A check whose parameter is not
+// configured is skipped, as is the whole evaluation when the PON has no
+// configuration (e.g. in tests)
+func (p *PonPort) checkOpticalBudget(onu *Onu) error {
+	ponConf, err := common.GetPonConfigById(p.ID)
+	if err != nil {
+		return nil
+	}
+	if ponConf.MaxDistanceKm > 0 && onu.DistanceKm > ponConf.MaxDistanceKm {
+		return fmt.Errorf("onu-%s-distance-%dkm-exceeds-pon-budget-%dkm", onu.Sn(), onu.DistanceKm, ponConf.MaxDistanceKm)
+	}
+	if ponConf.RxSensitivityDbm != 0 && onu.RxPower < ponConf.RxSensitivityDbm {
+		return fmt.Errorf("onu-%s-rx-power-%.1fdbm-below-pon-sensitivity-%.1fdbm", onu.Sn(), onu.RxPower, ponConf.RxSensitivityDbm)
+	}
+	return nil
+}
+
+// packetDelay returns the artificial propagation delay for this PON port,
+// composed of the configured latency plus a random jitter component
+func (p *PonPort) packetDelay() time.Duration {
+	delay := p.Latency
+	if p.Jitter > 0 {
+		delay += time.Duration(rand.Int63n(int64(p.Jitter)))
+	}
+	return delay
+}
+
 // GetNumOfActiveOnus returns number of active ONUs for PON port
 func (p *PonPort) GetNumOfActiveOnus() uint32 {
 	var count uint32 = 0
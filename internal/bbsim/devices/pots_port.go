@@ -25,7 +25,7 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-var potsLogger = log.WithFields(log.Fields{
+var potsLogger = deviceLogger.WithFields(log.Fields{
 	"module": "POTS",
 })
 
@@ -65,7 +65,7 @@ func createTestService(needsEapol bool, needsDchp bool) (*Service, error) {
 	uni := UniPort{ID: 1, Onu: onu}
 	return NewService(0, "testService", mac, &uni, 900, 900,
 		needsEapol, needsDchp, false, false, 64, 0, false, false,
-		7, 7, 7, 7)
+		7, 7, 7, 7, "", "")
 }
 
 // test the internalState transitions
@@ -357,3 +357,63 @@ func TestService_DHCPRestart(t *testing.T) {
 	time.Sleep(1 * time.Second)
 	assert.Equal(t, "dhcp_discovery_sent", s.DHCPState.Current())
 }
+
+// when the outcome is simulated no packet reaches the stream and the
+// state machine completes with a successful authentication
+func TestService_HandleAuth_simulatedSuccess(t *testing.T) {
+	s, err := createTestService(true, false)
+
+	assert.Nil(t, err)
+	s.EapolResult = "success"
+
+	stream := &mockStream{
+		Calls: make(map[int]*openolt.Indication),
+	}
+	s.Initialize(stream)
+
+	s.HandleAuth()
+	time.Sleep(1 * time.Second)
+
+	assert.Equal(t, stream.CallCount, 0)
+	assert.Equal(t, s.EapolState.Current(), eapol.StateResponseSuccessReceived)
+}
+
+// a simulated failure moves the state machine to auth_failed without
+// touching the stream
+func TestService_HandleAuth_simulatedFailure(t *testing.T) {
+	s, err := createTestService(true, false)
+
+	assert.Nil(t, err)
+	s.EapolResult = "failure"
+
+	stream := &mockStream{
+		Calls: make(map[int]*openolt.Indication),
+	}
+	s.Initialize(stream)
+
+	s.HandleAuth()
+	time.Sleep(1 * time.Second)
+
+	assert.Equal(t, stream.CallCount, 0)
+	assert.Equal(t, s.EapolState.Current(), eapol.StateAuthFailed)
+}
+
+// a service with a static IP reaches dhcp_ack_received without any
+// packet on the stream
+func TestService_HandleDhcp_staticIp(t *testing.T) {
+	s, err := createTestService(false, true)
+
+	assert.Nil(t, err)
+	s.StaticIp = "10.10.10.2"
+
+	stream := &mockStream{
+		Calls: make(map[int]*openolt.Indication),
+	}
+	s.Initialize(stream)
+
+	s.HandleDhcp(7, 900)
+	time.Sleep(1 * time.Second)
+
+	assert.Equal(t, 0, stream.CallCount)
+	assert.Equal(t, "dhcp_ack_received", s.DHCPState.Current())
+}
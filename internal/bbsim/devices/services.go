@@ -22,6 +22,7 @@ import (
 	"net"
 	"time"
 
+	"github.com/google/gopacket/layers"
 	"github.com/looplab/fsm"
 	"github.com/opencord/bbsim/internal/bbsim/packetHandlers"
 	"github.com/opencord/bbsim/internal/bbsim/responders/dhcp"
@@ -32,7 +33,7 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-var serviceLogger = log.WithFields(log.Fields{
+var serviceLogger = deviceLogger.WithFields(log.Fields{
 	"module": "SERVICE",
 })
 
@@ -81,8 +82,19 @@ type Service struct {
 	UsPonSTagPriority   uint8
 	DsPonCTagPriority   uint8
 	DsPonSTagPriority   uint8
+	// when set to "success" or "failure" the EAPOL exchange is resolved
+	// locally with that outcome instead of being relayed to the adapter,
+	// so AAA-gated subscribers can be tested without an authenticator
+	EapolResult string
+	// when set the service is marked IP-configured with this address
+	// without going through a DHCP exchange, modeling static-IP
+	// subscribers next to DHCP ones
+	StaticIp string
 
 	// state
+	// the IP address the service ended up with, either the configured
+	// static one or the address leased through the DHCP exchange
+	AssignedIp    string
 	GemPort       uint32
 	InternalState *fsm.FSM
 	EapolState    *fsm.FSM
@@ -96,7 +108,7 @@ type Service struct {
 func NewService(id uint32, name string, hwAddress net.HardwareAddr, uni *UniPort, cTag int, sTag int,
 	needsEapol bool, needsDchp bool, needsIgmp bool, needsPPPoE bool, tpID int, uniTagMatch int, configMacAddress bool,
 	enableMacLearning bool, usPonCTagPriority uint8, usPonSTagPriority uint8, dsPonCTagPriority uint8,
-	dsPonSTagPriority uint8) (*Service, error) {
+	dsPonSTagPriority uint8, eapolResult string, staticIp string) (*Service, error) {
 
 	service := Service{
 		Id:                  id,
@@ -117,6 +129,8 @@ func NewService(id uint32, name string, hwAddress net.HardwareAddr, uni *UniPort
 		UsPonSTagPriority:   usPonSTagPriority,
 		DsPonCTagPriority:   dsPonCTagPriority,
 		DsPonSTagPriority:   dsPonSTagPriority,
+		EapolResult:         eapolResult,
+		StaticIp:            staticIp,
 	}
 
 	service.InternalState = fsm.NewFSM(
@@ -189,7 +203,7 @@ func NewService(id uint32, name string, hwAddress net.HardwareAddr, uni *UniPort
 								"context": service.UniPort.Onu.PonPort.Olt.enableContext,
 							}).Debug("EAPOL cancelled, OLT is disabled")
 							return
-						case <-time.After(eapolWaitTime):
+						case <-deviceClock.After(eapolWaitTime):
 							if service.EapolState.Current() != eapol.StateResponseSuccessReceived {
 								serviceLogger.WithFields(log.Fields{
 									"OnuId":      service.UniPort.Onu.ID,
@@ -245,7 +259,7 @@ func NewService(id uint32, name string, hwAddress net.HardwareAddr, uni *UniPort
 								"context": service.UniPort.Onu.PonPort.Olt.enableContext,
 							}).Debug("DHCP cancelled, OLT is disabled")
 							return
-						case <-time.After(dhcpWaitTime):
+						case <-deviceClock.After(dhcpWaitTime):
 							if service.DHCPState.Current() != "dhcp_ack_received" {
 								serviceLogger.WithFields(log.Fields{
 									"OnuId":     service.UniPort.Onu.ID,
@@ -469,6 +483,16 @@ func (s *Service) HandlePackets() {
 			}
 
 			_ = dhcp.HandleNextPacket(s.UniPort.Onu.ID, s.UniPort.Onu.PonPortID, s.Name, s.UniPort.Onu.Sn(), s.UniPort.PortNo, tag, s.GemPort, s.UniPort.ID, s.HwAddress, s.DHCPState, msg.Packet, priority, s.Stream)
+
+			// remember the leased address once the exchange completes, so
+			// the API can report the IP the subscriber ended up with
+			if dhcpLayer, err := dhcp.GetDhcpLayer(msg.Packet); err == nil {
+				if dhcpLayer.Operation == layers.DHCPOpReply {
+					if msgType, err := dhcp.GetDhcpMessageType(dhcpLayer); err == nil && msgType == layers.DHCPMsgTypeAck {
+						s.AssignedIp = dhcpLayer.YourClientIP.String()
+					}
+				}
+			}
 		} else if msg.Type == packetHandlers.IGMP {
 			log.Warn(hex.EncodeToString(msg.Packet.Data()))
 			_ = igmp.HandleNextPacket(s.UniPort.Onu.PonPortID, s.UniPort.Onu.ID, s.UniPort.Onu.Sn(), s.UniPort.PortNo, s.GemPort, s.HwAddress, msg.Packet, s.CTag, s.UsPonCTagPriority, s.Stream)
@@ -612,6 +636,10 @@ func (s *Service) handleEapolStart(stream bbsimTypes.Stream) error {
 		"Name":      s.Name,
 	}).Debug("handleEapolStart")
 
+	if s.EapolResult != "" {
+		return s.simulateEapolExchange()
+	}
+
 	if err := eapol.SendEapStart(s.UniPort.Onu.ID, s.UniPort.Onu.PonPortID, s.UniPort.Onu.Sn(), s.UniPort.PortNo,
 		s.HwAddress, s.GemPort, s.UniPort.ID, s.EapolState, stream); err != nil {
 		serviceLogger.WithFields(log.Fields{
@@ -626,6 +654,40 @@ func (s *Service) handleEapolStart(stream bbsimTypes.Stream) error {
 	return nil
 }
 
+// simulateEapolExchange resolves the EAPOL exchange locally instead of
+// relaying it through the openolt stream, driving the authentication state
+// machine to the outcome configured via eapol_result. The outcome is
+// observable on the events channel
+func (s *Service) simulateEapolExchange() error {
+	onu := s.UniPort.Onu
+	serviceLogger.WithFields(log.Fields{
+		"OnuId":       onu.ID,
+		"IntfId":      onu.PonPortID,
+		"OnuSn":       onu.Sn(),
+		"PortNo":      s.UniPort.PortNo,
+		"UniId":       s.UniPort.ID,
+		"Name":        s.Name,
+		"EapolResult": s.EapolResult,
+	}).Info("Simulating EAPOL exchange")
+
+	if s.EapolResult == "failure" {
+		if err := s.EapolState.Event(eapol.EventAuthFailed); err != nil {
+			return err
+		}
+		publishEvent("Service-authentication-failure-simulated", int32(onu.PonPortID), int32(onu.ID), onu.Sn())
+		return nil
+	}
+
+	for _, event := range []string{eapol.EventStartSent, eapol.EventResponseIdentitySent,
+		eapol.EventResponseChallengeSent, eapol.EventResponseSuccessReceived} {
+		if err := s.EapolState.Event(event); err != nil {
+			return err
+		}
+	}
+	publishEvent("Service-authentication-success-simulated", int32(onu.PonPortID), int32(onu.ID), onu.Sn())
+	return nil
+}
+
 func (s *Service) handleDHCPStart(stream bbsimTypes.Stream) error {
 	serviceLogger.WithFields(log.Fields{
 		"OnuId":     s.UniPort.Onu.ID,
@@ -637,6 +699,10 @@ func (s *Service) handleDHCPStart(stream bbsimTypes.Stream) error {
 		"UniId":     s.UniPort.ID,
 	}).Debugf("HandleDHCPStart")
 
+	if s.StaticIp != "" {
+		return s.configureStaticIp()
+	}
+
 	tag := int(s.CTag)
 	priority := s.UsPonCTagPriority
 	if s.Name == fttbDpuMgmtServiceName {
@@ -660,6 +726,31 @@ func (s *Service) handleDHCPStart(stream bbsimTypes.Stream) error {
 	return nil
 }
 
+// configureStaticIp marks the service as IP-configured without a DHCP
+// exchange, walking the DHCP state machine to dhcp_ack_received so the
+// service reaches the connected state through the static path
+func (s *Service) configureStaticIp() error {
+	onu := s.UniPort.Onu
+	serviceLogger.WithFields(log.Fields{
+		"OnuId":    onu.ID,
+		"IntfId":   onu.PonPortID,
+		"OnuSn":    onu.Sn(),
+		"PortNo":   s.UniPort.PortNo,
+		"UniId":    s.UniPort.ID,
+		"Name":     s.Name,
+		"StaticIp": s.StaticIp,
+	}).Info("Configuring static IP for the service")
+
+	for _, event := range []string{"dhcp_discovery_sent", "dhcp_request_sent", "dhcp_ack_received"} {
+		if err := s.DHCPState.Event(event); err != nil {
+			return err
+		}
+	}
+	s.AssignedIp = s.StaticIp
+	publishEvent("Service-static-ip-configured", int32(onu.PonPortID), int32(onu.ID), onu.Sn())
+	return nil
+}
+
 func (s *Service) logStateChange(stateMachine string, src string, dst string) {
 	serviceLogger.WithFields(log.Fields{
 		"OnuId":     s.UniPort.Onu.ID,
@@ -0,0 +1,136 @@
+/*
+ * Copyright 2018-2023 Open Networking Foundation (ONF) and the ONF Contributors
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+ * http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package devices
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/opencord/voltha-protos/v5/go/openolt"
+	log "github.com/sirupsen/logrus"
+)
+
+// provisionedStateVersion guards against restoring a dump produced by an
+// incompatible simulator build
+const provisionedStateVersion = 1
+
+// OnuProvisionedRecord is the per-ONU portion of a provisioned state dump
+type OnuProvisionedRecord struct {
+	SerialNumber string
+	PonPortID    uint32
+	OnuID        uint32
+	// informational only, the FSM is not restored
+	InternalState string
+}
+
+// OltProvisionedState is the JSON-serializable dump of the state provisioned
+// on the OLT at runtime: ONU records, flows and the BOSS MAC-layer config.
+// It lets a test jump straight to a known provisioned state instead of
+// replaying every provisioning RPC
+type OltProvisionedState struct {
+	Version     int
+	BossMac     bossMacConfig
+	ErrorPermit int32
+	Onus        []OnuProvisionedRecord
+	Flows       []*openolt.Flow
+}
+
+// DumpProvisionedState serializes the provisioned OLT state to JSON
+func (o *OltDevice) DumpProvisionedState() ([]byte, error) {
+	dump := OltProvisionedState{
+		Version: provisionedStateVersion,
+		Onus:    []OnuProvisionedRecord{},
+		Flows:   []*openolt.Flow{},
+	}
+
+	o.bossMu.RLock()
+	dump.BossMac = o.bossMac
+	dump.ErrorPermit = o.errorPermit
+	o.bossMu.RUnlock()
+
+	for _, pon := range o.Pons {
+		for _, onu := range pon.Onus {
+			dump.Onus = append(dump.Onus, OnuProvisionedRecord{
+				SerialNumber:  onu.Sn(),
+				PonPortID:     onu.PonPortID,
+				OnuID:         onu.ID,
+				InternalState: onu.InternalState.Current(),
+			})
+		}
+	}
+
+	o.Flows.Range(func(_, value interface{}) bool {
+		flow := value.(openolt.Flow)
+		dump.Flows = append(dump.Flows, &flow)
+		return true
+	})
+
+	return json.MarshalIndent(dump, "", "  ")
+}
+
+// RestoreProvisionedState rebuilds the provisioned OLT state from a dump
+// produced by DumpProvisionedState. Flows are replayed through the same
+// bookkeeping FlowAdd uses so the alloc/gem resource maps come out
+// consistent with the flow table
+func (o *OltDevice) RestoreProvisionedState(data []byte) error {
+	var dump OltProvisionedState
+	if err := json.Unmarshal(data, &dump); err != nil {
+		return fmt.Errorf("cannot-parse-state-dump: %v", err)
+	}
+	if dump.Version != provisionedStateVersion {
+		return fmt.Errorf("unsupported-state-dump-version-%d", dump.Version)
+	}
+
+	o.bossMu.Lock()
+	o.bossMac = dump.BossMac
+	o.errorPermit = dump.ErrorPermit
+	o.bossMu.Unlock()
+
+	for _, record := range dump.Onus {
+		onu, err := o.FindOnuBySn(record.SerialNumber)
+		if err != nil {
+			oltLogger.WithFields(log.Fields{
+				"SerialNumber": record.SerialNumber,
+			}).Warn("skipping-onu-record-not-present-in-topology")
+			continue
+		}
+		onu.SetID(record.OnuID)
+
+		// enable the resource maps for this ONU as ActivateOnu would
+		o.AllocIDsLock.Lock()
+		o.AllocIDs[record.PonPortID][record.OnuID] = make(map[uint32]map[int32]map[uint64]bool)
+		o.AllocIDsLock.Unlock()
+		o.GemPortIDsLock.Lock()
+		o.GemPortIDs[record.PonPortID][record.OnuID] = make(map[uint32]map[int32]map[uint64]bool)
+		o.GemPortIDsLock.Unlock()
+	}
+
+	for _, flow := range dump.Flows {
+		o.Flows.Store(FlowKey{ID: flow.FlowId}, *flow)
+		if flow.AccessIntfId != -1 && flow.FlowType != "multicast" {
+			o.storeGemPortIdByFlow(flow)
+			o.storeAllocId(flow)
+		}
+	}
+
+	oltLogger.WithFields(log.Fields{
+		"Onus":  len(dump.Onus),
+		"Flows": len(dump.Flows),
+	}).Info("restored-provisioned-olt-state")
+
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright 2018-2023 Open Networking Foundation (ONF) and the ONF Contributors
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+ * http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package devices
+
+import (
+	"testing"
+
+	"github.com/opencord/voltha-protos/v5/go/openolt"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Olt_SnapshotRestoreProvisionedState(t *testing.T) {
+	olt := createMockOlt(1, 2, 1, []ServiceIf{})
+	olt.bossMac = bossMacConfig{Mtu: 1500, VlanMode: 1, AgingMode: 1, AgingTime: 300}
+	olt.errorPermit = 7
+
+	flow := openolt.Flow{
+		AccessIntfId: 0,
+		OnuId:        0,
+		UniId:        0,
+		PortNo:       16,
+		FlowId:       64,
+		AllocId:      1024,
+		GemportId:    1025,
+		FlowType:     "upstream",
+		Classifier:   &openolt.Classifier{},
+		Action:       &openolt.Action{},
+	}
+	olt.Flows.Store(FlowKey{ID: flow.FlowId}, flow)
+	olt.storeGemPortIdByFlow(&flow)
+	olt.storeAllocId(&flow)
+
+	data, err := olt.DumpProvisionedState()
+	assert.NoError(t, err)
+
+	// restore into a fresh OLT with the same topology
+	restored := createMockOlt(1, 2, 1, []ServiceIf{})
+	err = restored.RestoreProvisionedState(data)
+	assert.NoError(t, err)
+
+	assert.Equal(t, int32(1500), restored.bossMac.Mtu)
+	assert.Equal(t, int32(300), restored.bossMac.AgingTime)
+	assert.Equal(t, int32(7), restored.errorPermit)
+
+	// the flow table and the resource maps must be rebuilt consistently
+	_, ok := restored.Flows.Load(FlowKey{ID: flow.FlowId})
+	assert.True(t, ok)
+	assert.True(t, restored.AllocIDs[0][0][16][1024][64])
+	assert.True(t, restored.GemPortIDs[0][0][16][1025][64])
+
+	// a dump from an incompatible build is rejected
+	err = restored.RestoreProvisionedState([]byte(`{"Version": 99}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright 2018-2023 Open Networking Foundation (ONF) and the ONF Contributors
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+ * http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package devices
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/opencord/bbsim/internal/common"
+)
+
+// the maximum valid value for a VLAN tag
+const maxVlanTag = 4096
+
+// TagAllocator hands out the service c-tags and s-tags used when creating
+// UNI ports. Allocation is deterministic: every ONU creation worker owns an
+// allocator seeded on its own per-PON range, so the assigned tags match a
+// serial pass over all PONs regardless of how creation is scheduled. The
+// lock makes a single allocator safe to share across goroutines.
+type TagAllocator struct {
+	mu       sync.Mutex
+	nextCtag map[string]int
+	nextStag map[string]int
+}
+
+// NewTagAllocator returns the allocator for the ONU creation worker of a
+// PON. unisPerPon is the number of UNI ports created per PON, which is the
+// number of unique tags a PON consumes per service.
+func NewTagAllocator(ponID int, unisPerPon int) *TagAllocator {
+	a := &TagAllocator{
+		nextCtag: map[string]int{},
+		nextStag: map[string]int{},
+	}
+	for _, s := range common.Services {
+		if s.CTagAllocation == common.TagAllocationUnique.String() {
+			// seeded one below the first value because Tags advances
+			// the counter before use when the service is known
+			a.nextCtag[s.Name] = s.CTag + ponID*unisPerPon - 1
+		} else {
+			a.nextCtag[s.Name] = s.CTag
+		}
+		if s.STagAllocation == common.TagAllocationUnique.String() {
+			a.nextStag[s.Name] = s.STag + ponID*unisPerPon - 1
+		} else {
+			a.nextStag[s.Name] = s.STag
+		}
+	}
+	return a
+}
+
+// Tags returns the c-tag and s-tag for the given service on the next UNI
+// port, advancing the counters for unique allocations. An error is returned
+// when an allocation would exceed the maximum valid tag.
+func (a *TagAllocator) Tags(s common.ServiceYaml) (int, int, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	cTag, ok := a.nextCtag[s.Name]
+	if !ok {
+		// the service was not known when the allocator was seeded,
+		// so we start from the config value
+		cTag = s.CTag
+	} else if s.CTagAllocation == common.TagAllocationUnique.String() {
+		cTag = cTag + 1
+	}
+	if cTag > maxVlanTag {
+		return 0, 0, fmt.Errorf("c-tag-limit-reached-too-many-subscribers")
+	}
+
+	sTag, ok := a.nextStag[s.Name]
+	if !ok {
+		sTag = s.STag
+	} else if s.STagAllocation == common.TagAllocationUnique.String() {
+		sTag = sTag + 1
+	}
+	if sTag > maxVlanTag {
+		return 0, 0, fmt.Errorf("s-tag-limit-reached-too-many-subscribers")
+	}
+
+	a.nextCtag[s.Name] = cTag
+	a.nextStag[s.Name] = sTag
+
+	return cTag, sTag, nil
+}
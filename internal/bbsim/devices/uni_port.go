@@ -29,7 +29,7 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-var uniLogger = log.WithFields(log.Fields{
+var uniLogger = deviceLogger.WithFields(log.Fields{
 	"module": "UNI",
 })
 
@@ -66,7 +66,7 @@ type UniPort struct {
 	PacketCh  chan bbsimTypes.OnuPacketMessage // handle packets
 }
 
-func NewUniPort(ID uint32, onu *Onu, nextCtag map[string]int, nextStag map[string]int) (*UniPort, error) {
+func NewUniPort(ID uint32, onu *Onu, tags *TagAllocator) (*UniPort, error) {
 
 	// IDs starts from 0, thus the maximum UNI supported is maxUniPorts - 1
 	if ID > (maxUniPorts - 1) {
@@ -132,52 +132,20 @@ func NewUniPort(ID uint32, onu *Onu, nextCtag map[string]int, nextStag map[strin
 
 	for k, s := range common.Services {
 
-		// find the correct cTag for this service
-		if _, ok := nextCtag[s.Name]; !ok {
-			// it's the first time we iterate over this service,
-			// so we start from the config value
-			nextCtag[s.Name] = s.CTag
-		} else {
-			// we have a previous value, so we check it
-			// if Allocation is unique, we increment,
-			// otherwise (shared) we do nothing
-			if s.CTagAllocation == common.TagAllocationUnique.String() {
-				nextCtag[s.Name] = nextCtag[s.Name] + 1
-
-				// the max valid value for a tag is 4096
-				// check we're not going over
-				if nextCtag[s.Name] > 4096 {
-					uni.logger.WithFields(log.Fields{
-						"cTag":    nextCtag[s.Name],
-						"Service": s.Name,
-					}).Fatal("c-tag-limit-reached-too-many-subscribers")
-				}
-			}
-		}
-
-		// find the correct sTag for this service
-		if _, ok := nextStag[s.Name]; !ok {
-			nextStag[s.Name] = s.STag
-		} else {
-			if s.STagAllocation == common.TagAllocationUnique.String() {
-				nextStag[s.Name] = nextStag[s.Name] + 1
-
-				// the max valid value for a tag is 4096
-				// check we're not going over
-				if nextStag[s.Name] > 4096 {
-					uni.logger.WithFields(log.Fields{
-						"sTag":    nextStag[s.Name],
-						"Service": s.Name,
-					}).Fatal("s-tag-limit-reached-too-many-subscribers")
-				}
-			}
+		// find the correct c-tag and s-tag for this service
+		cTag, sTag, err := tags.Tags(s)
+		if err != nil {
+			uni.logger.WithFields(log.Fields{
+				"Service": s.Name,
+			}).Error("cannot-allocate-service-tags")
+			return nil, err
 		}
 
 		mac := net.HardwareAddr{0x2e, byte(olt.ID), byte(onu.PonPortID), byte(onu.ID), byte(uni.ID), byte(k)}
-		service, err := NewService(uint32(k), s.Name, mac, &uni, nextCtag[s.Name], nextStag[s.Name],
+		service, err := NewService(uint32(k), s.Name, mac, &uni, cTag, sTag,
 			s.NeedsEapol, s.NeedsDhcp, s.NeedsIgmp, s.NeedsPPPoE, s.TechnologyProfileID, s.UniTagMatch,
 			s.ConfigureMacAddress, s.EnableMacLearning, s.UsPonCTagPriority, s.UsPonSTagPriority,
-			s.DsPonCTagPriority, s.DsPonSTagPriority)
+			s.DsPonCTagPriority, s.DsPonSTagPriority, s.EapolResult, s.StaticIp)
 
 		if err != nil {
 			oltLogger.WithFields(log.Fields{
@@ -193,6 +161,62 @@ func NewUniPort(ID uint32, onu *Onu, nextCtag map[string]int, nextStag map[strin
 	return &uni, nil
 }
 
+// AddService instantiates a service on the UNI at runtime, hooks it to the
+// current openolt stream and initializes it right away if the UNI is up
+func (u *UniPort) AddService(sYaml common.ServiceYaml, cTag int, sTag int) (*Service, error) {
+	for _, s := range u.Services {
+		if s.(*Service).Name == sYaml.Name {
+			return nil, fmt.Errorf("service-%s-already-configured-on-uni-%d", sYaml.Name, u.ID)
+		}
+	}
+
+	k := len(u.Services)
+	mac := net.HardwareAddr{0x2e, byte(olt.ID), byte(u.Onu.PonPortID), byte(u.Onu.ID), byte(u.ID), byte(k)}
+	service, err := NewService(uint32(k), sYaml.Name, mac, u, cTag, sTag,
+		sYaml.NeedsEapol, sYaml.NeedsDhcp, sYaml.NeedsIgmp, sYaml.NeedsPPPoE, sYaml.TechnologyProfileID, sYaml.UniTagMatch,
+		sYaml.ConfigureMacAddress, sYaml.EnableMacLearning, sYaml.UsPonCTagPriority, sYaml.UsPonSTagPriority,
+		sYaml.DsPonCTagPriority, sYaml.DsPonSTagPriority, sYaml.EapolResult, sYaml.StaticIp)
+	if err != nil {
+		return nil, err
+	}
+
+	u.Services = append(u.Services, service)
+
+	if stream := u.Onu.PonPort.Olt.OpenoltStream; stream != nil {
+		service.UpdateStream(stream)
+		if u.OperState.Is(UniStateUp) {
+			service.Initialize(stream)
+		}
+	}
+
+	u.logger.WithFields(log.Fields{
+		"Service": service.Name,
+		"CTag":    service.CTag,
+		"STag":    service.STag,
+	}).Info("service-added-to-uni")
+	publishEvent("Service-added", int32(u.Onu.PonPortID), int32(u.Onu.ID), u.Onu.Sn())
+
+	return service, nil
+}
+
+// RemoveService disables and removes a service from the UNI at runtime
+func (u *UniPort) RemoveService(name string) error {
+	for i, s := range u.Services {
+		service := s.(*Service)
+		if service.Name == name {
+			service.Disable()
+			u.Services = append(u.Services[:i], u.Services[i+1:]...)
+
+			u.logger.WithFields(log.Fields{
+				"Service": name,
+			}).Info("service-removed-from-uni")
+			publishEvent("Service-removed", int32(u.Onu.PonPortID), int32(u.Onu.ID), u.Onu.Sn())
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot-find-service-%s-on-uni-%d", name, u.ID)
+}
+
 func (u *UniPort) GetID() uint32 {
 	return u.ID
 }
@@ -142,7 +142,8 @@ func TestNewUniPortAtt(t *testing.T) {
 				SerialNumber: NewSN(1, 1, 1),
 			}
 
-			uni, err := NewUniPort(1, onu, tt.args.nextCtag, tt.args.nextStag)
+			tags := &TagAllocator{nextCtag: tt.args.nextCtag, nextStag: tt.args.nextStag}
+			uni, err := NewUniPort(1, onu, tags)
 
 			assert.NoError(t, err)
 
@@ -30,6 +30,13 @@ func IsDhcpPacket(pkt gopacket.Packet) bool {
 	return false
 }
 
+func IsDhcpv6Packet(pkt gopacket.Packet) bool {
+	if layerDHCPv6 := pkt.Layer(layers.LayerTypeDHCPv6); layerDHCPv6 != nil {
+		return true
+	}
+	return false
+}
+
 func IsIgmpPacket(pkt gopacket.Packet) bool {
 	if igmpLayer := pkt.Layer(layers.LayerTypeIGMP); igmpLayer != nil {
 		return true
@@ -37,6 +44,15 @@ func IsIgmpPacket(pkt gopacket.Packet) bool {
 	return false
 }
 
+// returns true if the bytes decoded as a well formed Ethernet frame,
+// a failed decode leaves an error layer and no Ethernet layer
+func IsEthernetPacket(pkt gopacket.Packet) bool {
+	if pkt.ErrorLayer() != nil {
+		return false
+	}
+	return pkt.Layer(layers.LayerTypeEthernet) != nil
+}
+
 func IsLldpPacket(pkt gopacket.Packet) bool {
 	if layer := pkt.Layer(layers.LayerTypeLinkLayerDiscovery); layer != nil {
 		return true
@@ -30,6 +30,7 @@ import (
 	"github.com/looplab/fsm"
 	"github.com/opencord/bbsim/internal/bbsim/packetHandlers"
 	bbsim "github.com/opencord/bbsim/internal/bbsim/types"
+	"github.com/opencord/bbsim/internal/common"
 	"github.com/opencord/voltha-protos/v5/go/openolt"
 	log "github.com/sirupsen/logrus"
 )
@@ -277,6 +278,7 @@ func sendDHCPPktIn(msg bbsim.ByteMsg, portNo uint32, gemPortId uint32, uniId uin
 		dhcpLogger.Errorf("Fail to send DHCP PktInd indication. %v", err)
 		return err
 	}
+	common.IncrementPktIndCount()
 	return nil
 }
 
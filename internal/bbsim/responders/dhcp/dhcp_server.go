@@ -21,6 +21,8 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"sync"
+	"time"
 
 	"github.com/google/gopacket"
 	"github.com/google/gopacket/layers"
@@ -28,21 +30,59 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// default duration of the leases assigned by the DHCP server
+const defaultLeaseTime = 3600 * time.Second
+
 type DHCPServerIf interface {
 	HandleServerPacket(pkt gopacket.Packet) (gopacket.Packet, error)
+	GetLeases() []DhcpLease
+}
+
+// DhcpLease describes an address assigned by the DHCP server
+type DhcpLease struct {
+	ClientMac  net.HardwareAddr
+	Ip         net.IP
+	LeaseTime  time.Duration
+	AssignedAt time.Time
 }
 
 type DHCPServer struct {
 	DHCPServerMacAddress net.HardwareAddr
+	leasesLock           sync.RWMutex
+	leases               map[string]DhcpLease
 }
 
 func NewDHCPServer() *DHCPServer {
 	return &DHCPServer{
 		// NOTE we may need to make this configurable in case we'll need multiple servers
 		DHCPServerMacAddress: net.HardwareAddr{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff},
+		leases:               make(map[string]DhcpLease),
+	}
+}
+
+// storeLease records the address assigned to a client when the Ack is sent
+func (s *DHCPServer) storeLease(clientMac net.HardwareAddr, ip net.IP) {
+	s.leasesLock.Lock()
+	defer s.leasesLock.Unlock()
+	s.leases[clientMac.String()] = DhcpLease{
+		ClientMac:  clientMac,
+		Ip:         ip,
+		LeaseTime:  defaultLeaseTime,
+		AssignedAt: time.Now(),
 	}
 }
 
+// GetLeases returns the leases currently assigned by the server
+func (s *DHCPServer) GetLeases() []DhcpLease {
+	s.leasesLock.RLock()
+	defer s.leasesLock.RUnlock()
+	leases := make([]DhcpLease, 0, len(s.leases))
+	for _, lease := range s.leases {
+		leases = append(leases, lease)
+	}
+	return leases
+}
+
 func (s *DHCPServer) getClientMacAddress(pkt gopacket.Packet) (net.HardwareAddr, error) {
 	dhcpLayer, err := GetDhcpLayer(pkt)
 	if err != nil {
@@ -297,6 +337,8 @@ func (s *DHCPServer) handleRequest(pkt gopacket.Packet) (gopacket.Packet, error)
 		Length: uint8(len(data)),
 	})
 
+	s.storeLease(clientMac, dhcpLayer.YourClientIP)
+
 	// serialize the packet
 	responsePkt, err := s.serializeServerDHCPPacket(clientMac, &dhcpLayer)
 	if err != nil {
@@ -319,7 +361,7 @@ func (s *DHCPServer) handleRequest(pkt gopacket.Packet) (gopacket.Packet, error)
 
 // HandleServerPacket is a very simple implementation of a DHCP server
 // that only replies to DHCPDiscover and DHCPRequest packets
-func (s DHCPServer) HandleServerPacket(pkt gopacket.Packet) (gopacket.Packet, error) {
+func (s *DHCPServer) HandleServerPacket(pkt gopacket.Packet) (gopacket.Packet, error) {
 	dhcpLayer, _ := GetDhcpLayer(pkt)
 
 	if dhcpLayer.Operation == layers.DHCPOpReply {
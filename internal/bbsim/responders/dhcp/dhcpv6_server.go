@@ -0,0 +1,181 @@
+/*
+ * Copyright 2018-2023 Open Networking Foundation (ONF) and the ONF Contributors
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+ * http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dhcp
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/opencord/bbsim/internal/bbsim/packetHandlers"
+	log "github.com/sirupsen/logrus"
+)
+
+type DHCPv6ServerIf interface {
+	HandleServerPacket(pkt gopacket.Packet) (gopacket.Packet, error)
+}
+
+type DHCPv6Server struct {
+	DHCPServerMacAddress net.HardwareAddr
+}
+
+func NewDHCPv6Server() *DHCPv6Server {
+	return &DHCPv6Server{
+		// NOTE we may need to make this configurable in case we'll need multiple servers
+		DHCPServerMacAddress: net.HardwareAddr{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff},
+	}
+}
+
+// GetDhcpv6Layer extracts the DHCPv6 layer from a packet
+func GetDhcpv6Layer(pkt gopacket.Packet) (*layers.DHCPv6, error) {
+	if layer := pkt.Layer(layers.LayerTypeDHCPv6); layer != nil {
+		dhcpv6, _ := layer.(*layers.DHCPv6)
+		return dhcpv6, nil
+	}
+	return nil, errors.New("no-dhcpv6-layer-in-packet")
+}
+
+// duid returns the DUID-LL identifying this server
+func (s *DHCPv6Server) duid() []byte {
+	// DUID type 3 (DUID-LL), hardware type 1 (ethernet)
+	duid := []byte{0x00, 0x03, 0x00, 0x01}
+	return append(duid, s.DHCPServerMacAddress...)
+}
+
+// linkLocalAddress derives the server link-local address from its MAC
+// using modified EUI-64
+func (s *DHCPv6Server) linkLocalAddress() net.IP {
+	mac := s.DHCPServerMacAddress
+	return net.IP{0xfe, 0x80, 0, 0, 0, 0, 0, 0,
+		mac[0] ^ 0x02, mac[1], mac[2], 0xff, 0xfe, mac[3], mac[4], mac[5]}
+}
+
+func (s *DHCPv6Server) createReply(request *layers.DHCPv6, msgType layers.DHCPv6MsgType) *layers.DHCPv6 {
+	reply := &layers.DHCPv6{
+		MsgType:       msgType,
+		TransactionID: request.TransactionID,
+		Options:       layers.DHCPv6Options{},
+	}
+
+	// echo the client identifier and the IA so the client can match the reply
+	for _, option := range request.Options {
+		if option.Code == layers.DHCPv6OptClientID || option.Code == layers.DHCPv6OptIANA {
+			reply.Options = append(reply.Options, option)
+		}
+	}
+	reply.Options = append(reply.Options, layers.NewDHCPv6Option(layers.DHCPv6OptServerID, s.duid()))
+
+	return reply
+}
+
+func (s *DHCPv6Server) serializeServerDHCPv6Packet(clientMac net.HardwareAddr, clientIp net.IP, dhcpv6Layer *layers.DHCPv6) (gopacket.Packet, error) {
+	buffer := gopacket.NewSerializeBuffer()
+
+	options := gopacket.SerializeOptions{
+		ComputeChecksums: true,
+		FixLengths:       true,
+	}
+
+	ethernetLayer := &layers.Ethernet{
+		SrcMAC:       s.DHCPServerMacAddress,
+		DstMAC:       clientMac,
+		EthernetType: layers.EthernetTypeIPv6,
+	}
+
+	ipLayer := &layers.IPv6{
+		Version:    6,
+		HopLimit:   64,
+		SrcIP:      s.linkLocalAddress(),
+		DstIP:      clientIp,
+		NextHeader: layers.IPProtocolUDP,
+	}
+
+	udpLayer := &layers.UDP{
+		SrcPort: 547,
+		DstPort: 546,
+	}
+
+	_ = udpLayer.SetNetworkLayerForChecksum(ipLayer)
+	if err := gopacket.SerializeLayers(buffer, options, ethernetLayer, ipLayer, udpLayer, dhcpv6Layer); err != nil {
+		dhcpLogger.Error("SerializeLayers")
+		return nil, err
+	}
+
+	return gopacket.NewPacket(buffer.Bytes(), layers.LayerTypeEthernet, gopacket.Default), nil
+}
+
+func (s *DHCPv6Server) handleMessage(pkt gopacket.Packet, request *layers.DHCPv6, msgType layers.DHCPv6MsgType) (gopacket.Packet, error) {
+	oTag, iTag, err := packetHandlers.GetTagsFromPacket(pkt)
+	if err != nil {
+		return nil, err
+	}
+
+	clientMac, err := packetHandlers.GetSrcMacAddressFromPacket(pkt)
+	if err != nil {
+		return nil, err
+	}
+
+	clientIp := net.IPv6linklocalallnodes
+	if ipLayer := pkt.Layer(layers.LayerTypeIPv6); ipLayer != nil {
+		ip, _ := ipLayer.(*layers.IPv6)
+		clientIp = ip.SrcIP
+	}
+
+	responsePkt, err := s.serializeServerDHCPv6Packet(clientMac, clientIp, s.createReply(request, msgType))
+	if err != nil {
+		return nil, err
+	}
+
+	var taggedResponsePkt gopacket.Packet
+	if iTag != 0 { //Double tagged
+		taggedResponsePkt, err = packetHandlers.PushDoubleTag(int(oTag), int(iTag), responsePkt, 0)
+	} else { //Single tagged
+		taggedResponsePkt, err = packetHandlers.PushSingleTag(int(oTag), responsePkt, 0)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return taggedResponsePkt, nil
+}
+
+// HandleServerPacket is a very simple implementation of a DHCPv6 server
+// that only replies to Solicit, Request, Renew and Rebind messages
+func (s *DHCPv6Server) HandleServerPacket(pkt gopacket.Packet) (gopacket.Packet, error) {
+	dhcpv6Layer, err := GetDhcpv6Layer(pkt)
+	if err != nil {
+		return nil, err
+	}
+
+	switch dhcpv6Layer.MsgType {
+	case layers.DHCPv6MsgTypeSolicit:
+		dhcpLogger.Info("Received DHCPv6 Solicit")
+		return s.handleMessage(pkt, dhcpv6Layer, layers.DHCPv6MsgTypeAdverstise)
+	case layers.DHCPv6MsgTypeRequest:
+		dhcpLogger.Info("Received DHCPv6 Request")
+		return s.handleMessage(pkt, dhcpv6Layer, layers.DHCPv6MsgTypeReply)
+	case layers.DHCPv6MsgTypeRenew, layers.DHCPv6MsgTypeRebind:
+		dhcpLogger.WithFields(log.Fields{
+			"MsgType": dhcpv6Layer.MsgType.String(),
+		}).Info("Received DHCPv6 lease extension")
+		return s.handleMessage(pkt, dhcpv6Layer, layers.DHCPv6MsgTypeReply)
+	}
+	return nil, fmt.Errorf("cannot-handle-dhcpv6-packet-of-type-%s", dhcpv6Layer.MsgType.String())
+}
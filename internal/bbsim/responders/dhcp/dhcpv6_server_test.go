@@ -0,0 +1,111 @@
+/*
+ * Copyright 2018-2023 Open Networking Foundation (ONF) and the ONF Contributors
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+ * http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dhcp
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/opencord/bbsim/internal/bbsim/packetHandlers"
+	"gotest.tools/assert"
+)
+
+func createDhcpv6Packet(t *testing.T, msgType layers.DHCPv6MsgType) gopacket.Packet {
+	clientMac := net.HardwareAddr{0x2e, 0x60, 0x00, 0x0c, 0x0f, 0x02}
+
+	ethernetLayer := &layers.Ethernet{
+		SrcMAC:       clientMac,
+		DstMAC:       net.HardwareAddr{0x33, 0x33, 0x00, 0x01, 0x00, 0x02},
+		EthernetType: layers.EthernetTypeIPv6,
+	}
+
+	ipLayer := &layers.IPv6{
+		Version:    6,
+		HopLimit:   1,
+		SrcIP:      net.ParseIP("fe80::1"),
+		DstIP:      net.ParseIP("ff02::1:2"),
+		NextHeader: layers.IPProtocolUDP,
+	}
+
+	udpLayer := &layers.UDP{
+		SrcPort: 546,
+		DstPort: 547,
+	}
+	_ = udpLayer.SetNetworkLayerForChecksum(ipLayer)
+
+	dhcpv6Layer := &layers.DHCPv6{
+		MsgType:       msgType,
+		TransactionID: []byte{0x01, 0x02, 0x03},
+		Options: layers.DHCPv6Options{
+			layers.NewDHCPv6Option(layers.DHCPv6OptClientID, append([]byte{0x00, 0x03, 0x00, 0x01}, clientMac...)),
+		},
+	}
+
+	buffer := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{ComputeChecksums: true, FixLengths: true}
+	if err := gopacket.SerializeLayers(buffer, opts, ethernetLayer, ipLayer, udpLayer, dhcpv6Layer); err != nil {
+		t.Fatal(err)
+	}
+
+	pkt := gopacket.NewPacket(buffer.Bytes(), layers.LayerTypeEthernet, gopacket.Default)
+	taggedPkt, err := packetHandlers.PushSingleTag(900, pkt, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return taggedPkt
+}
+
+func TestHandleServerPacketDhcpv6Solicit(t *testing.T) {
+	dhcpv6Server := NewDHCPv6Server()
+
+	solicit := createDhcpv6Packet(t, layers.DHCPv6MsgTypeSolicit)
+	response, err := dhcpv6Server.HandleServerPacket(solicit)
+	assert.NilError(t, err)
+
+	dhcpv6Layer, err := GetDhcpv6Layer(response)
+	assert.NilError(t, err)
+	assert.Equal(t, layers.DHCPv6MsgTypeAdverstise, dhcpv6Layer.MsgType)
+
+	// the reply must carry the server identifier and echo the client one
+	foundClientId := false
+	foundServerId := false
+	for _, option := range dhcpv6Layer.Options {
+		switch option.Code {
+		case layers.DHCPv6OptClientID:
+			foundClientId = true
+		case layers.DHCPv6OptServerID:
+			foundServerId = true
+		}
+	}
+	assert.Equal(t, true, foundClientId)
+	assert.Equal(t, true, foundServerId)
+
+	// the reply is tagged with the same VLAN of the request
+	vid, err := packetHandlers.GetVlanTag(response)
+	assert.NilError(t, err)
+	assert.Equal(t, uint16(900), vid)
+}
+
+func TestHandleServerPacketDhcpv6UnsupportedType(t *testing.T) {
+	dhcpv6Server := NewDHCPv6Server()
+
+	release := createDhcpv6Packet(t, layers.DHCPv6MsgTypeRelease)
+	_, err := dhcpv6Server.HandleServerPacket(release)
+	assert.Error(t, err, "cannot-handle-dhcpv6-packet-of-type-Release")
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/google/gopacket/layers"
 	"github.com/looplab/fsm"
 	bbsim "github.com/opencord/bbsim/internal/bbsim/types"
+	"github.com/opencord/bbsim/internal/common"
 	"github.com/opencord/voltha-protos/v5/go/openolt"
 	log "github.com/sirupsen/logrus"
 )
@@ -81,6 +82,7 @@ func sendEapolPktIn(msg bbsim.ByteMsg, portNo uint32, gemid uint32, stream bbsim
 		eapolLogger.Errorf("Fail to send EAPOL PktInd indication. %v", err)
 		return err
 	}
+	common.IncrementPktIndCount()
 	return nil
 }
 
@@ -282,6 +284,7 @@ func SendEapStart(onuId uint32, ponPortId uint32, serialNumber string, portNo ui
 		}
 		return err
 	}
+	common.IncrementPktIndCount()
 
 	eapolLogger.WithFields(log.Fields{
 		"OnuId":     onuId,
@@ -24,6 +24,7 @@ import (
 	"github.com/google/gopacket"
 	"github.com/google/gopacket/layers"
 	bbsim "github.com/opencord/bbsim/internal/bbsim/types"
+	"github.com/opencord/bbsim/internal/common"
 	"github.com/opencord/voltha-protos/v5/go/openolt"
 	log "github.com/sirupsen/logrus"
 )
@@ -72,6 +73,7 @@ func SendIGMPLeaveGroupV2(ponPortId uint32, onuId uint32, serialNumber string, p
 		}).Error("Fail to send IGMP PktInd indication for ONU")
 		return err
 	}
+	common.IncrementPktIndCount()
 	return nil
 }
 
@@ -114,6 +116,7 @@ func SendIGMPMembershipReportV2(ponPortId uint32, onuId uint32, serialNumber str
 		}).Errorf("Fail to send IGMP PktInd indication")
 		return err
 	}
+	common.IncrementPktIndCount()
 
 	log.WithFields(log.Fields{
 		"OnuId":        onuId,
@@ -168,6 +171,7 @@ func SendIGMPMembershipReportV3(ponPortId uint32, onuId uint32, serialNumber str
 		}).Errorf("Fail to send IGMP PktInd indication")
 		return err
 	}
+	common.IncrementPktIndCount()
 	return nil
 }
 
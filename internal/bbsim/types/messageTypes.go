@@ -52,6 +52,7 @@ const (
 	AlarmIndication        MessageType = 16 // message data is an openolt.AlarmIndication
 	IGMPMembershipReportV3 MessageType = 17 // Version 3 Membership Report
 	UniStatusAlarm         MessageType = 19
+	MeAlarm                MessageType = 20 // OMCI alarm notification for an ME declaring an alarm map
 )
 
 func (m MessageType) String() string {
@@ -76,6 +77,7 @@ func (m MessageType) String() string {
 		"IGMPMembershipReportV3",
 		"FlowRemoved",
 		"UniStatusAlarm",
+		"MeAlarm",
 	}
 	return names[m]
 }
@@ -127,6 +129,13 @@ type UniStatusAlarmMessage struct {
 	RaiseOMCIAlarm bool
 }
 
+type MeAlarmMessage struct {
+	EntityClass uint16
+	EntityID    uint16
+	AlarmBit    uint8
+	Raise       bool
+}
+
 // these are OMCI messages going from the OLT to VOLTHA
 // these messages are received by BBR
 type OmciIndicationMessage struct {
@@ -17,6 +17,8 @@
 package common
 
 import (
+	"encoding/hex"
+	"fmt"
 	"net"
 	"strconv"
 
@@ -31,6 +33,22 @@ func OnuSnToString(sn *openolt.SerialNumber) string {
 	return s
 }
 
+// OnuSnFromString parses a serial number in the format produced by OnuSnToString
+// (a 4 character vendor id followed by 8 hex digits)
+func OnuSnFromString(s string) (*openolt.SerialNumber, error) {
+	if len(s) != 12 {
+		return nil, fmt.Errorf("invalid-serial-number-%s", s)
+	}
+	vendorSpecific, err := hex.DecodeString(s[4:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid-serial-number-%s", s)
+	}
+	return &openolt.SerialNumber{
+		VendorId:       []byte(s[:4]),
+		VendorSpecific: vendorSpecific,
+	}, nil
+}
+
 // GetIPAddr returns the IPv4 address of an interface. 0.0.0.0 is returned if the IP cannot be determined.
 func GetIPAddr(ifname string) (string, error) {
 	ip := "0.0.0.0"
@@ -37,6 +37,9 @@ type Event struct {
 	OnuID     int32
 	EpochTime int64
 	Timestamp string
+	// signature values carried by the OLT-heartbeat-signature-changed event
+	OldSignature uint32 `json:",omitempty"`
+	NewSignature uint32 `json:",omitempty"`
 }
 
 // InitializePublisher initalizes kafka publisher
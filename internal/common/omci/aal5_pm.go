@@ -0,0 +1,124 @@
+/*
+ * Copyright 2018-2023 Open Networking Foundation (ONF) and the ONF Contributors
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+ * http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package omci
+
+import (
+	"errors"
+
+	"github.com/google/gopacket"
+	"github.com/opencord/omci-lib-go/v2"
+	me "github.com/opencord/omci-lib-go/v2/generated"
+	log "github.com/sirupsen/logrus"
+)
+
+// Aal5PmCounters holds the injected counters served by the
+// Aal5PerformanceMonitoringHistoryData ME
+type Aal5PmCounters struct {
+	SumOfInvalidCsFieldErrors  uint32
+	CrcViolations              uint32
+	ReassemblyTimerExpirations uint32
+	BufferOverflows            uint32
+	EncapProtocolErrors        uint32
+}
+
+func aal5PmAttributes(entityInstance uint16, intervalEndTime uint8, counters Aal5PmCounters) me.AttributeValueMap {
+	return me.AttributeValueMap{
+		me.ManagedEntityID: entityInstance,
+		me.Aal5PerformanceMonitoringHistoryData_IntervalEndTime:            intervalEndTime,
+		me.Aal5PerformanceMonitoringHistoryData_ThresholdData12Id:          0,
+		me.Aal5PerformanceMonitoringHistoryData_SumOfInvalidCsFieldErrors:  counters.SumOfInvalidCsFieldErrors,
+		me.Aal5PerformanceMonitoringHistoryData_CrcViolations:              counters.CrcViolations,
+		me.Aal5PerformanceMonitoringHistoryData_ReassemblyTimerExpirations: counters.ReassemblyTimerExpirations,
+		me.Aal5PerformanceMonitoringHistoryData_BufferOverflows:            counters.BufferOverflows,
+		me.Aal5PerformanceMonitoringHistoryData_EncapProtocolErrors:        counters.EncapProtocolErrors,
+	}
+}
+
+func ParseGetCurrentDataRequest(omciPkt gopacket.Packet) (*omci.GetCurrentDataRequest, error) {
+	msgLayer := omciPkt.Layer(omci.LayerTypeGetCurrentDataRequest)
+	if msgLayer == nil {
+		err := "omci Msg layer could not be detected for LayerTypeGetCurrentDataRequest"
+		omciLogger.Error(err)
+		return nil, errors.New(err)
+	}
+	msgObj, msgOk := msgLayer.(*omci.GetCurrentDataRequest)
+	if !msgOk {
+		err := "omci Msg layer could not be assigned for LayerTypeGetCurrentDataRequest"
+		omciLogger.Error(err)
+		return nil, errors.New(err)
+	}
+	return msgObj, nil
+}
+
+// CreateAal5PmGetResponse answers a Get on the AAL5 PM history ME with the
+// counters injected on the ONU and the interval end time of the closed
+// 15 minute window
+func CreateAal5PmGetResponse(omciPkt gopacket.Packet, omciMsg *omci.OMCI, intervalEndTime uint8, counters Aal5PmCounters) ([]byte, error) {
+	msgObj, err := ParseGetRequest(omciPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &omci.GetResponse{
+		MeBasePacket: omci.MeBasePacket{
+			EntityClass:    msgObj.EntityClass,
+			EntityInstance: msgObj.EntityInstance,
+			Extended:       omciMsg.DeviceIdentifier == omci.ExtendedIdent,
+		},
+		Attributes:    aal5PmAttributes(msgObj.EntityInstance, intervalEndTime, counters),
+		AttributeMask: msgObj.AttributeMask,
+		Result:        me.Success,
+	}
+
+	pkt, err := Serialize(omci.GetResponseType, response, omciMsg.TransactionID)
+	if err != nil {
+		omciLogger.WithFields(log.Fields{
+			"Err": err,
+		}).Error("cannot-Serialize-GetResponse")
+		return nil, err
+	}
+	return pkt, nil
+}
+
+// CreateAal5PmGetCurrentDataResponse answers a GetCurrentData on the AAL5 PM
+// history ME with the counters of the interval still accruing
+func CreateAal5PmGetCurrentDataResponse(omciPkt gopacket.Packet, omciMsg *omci.OMCI, intervalEndTime uint8, counters Aal5PmCounters) ([]byte, error) {
+	msgObj, err := ParseGetCurrentDataRequest(omciPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &omci.GetCurrentDataResponse{
+		MeBasePacket: omci.MeBasePacket{
+			EntityClass:    msgObj.EntityClass,
+			EntityInstance: msgObj.EntityInstance,
+			Extended:       omciMsg.DeviceIdentifier == omci.ExtendedIdent,
+		},
+		Attributes:    aal5PmAttributes(msgObj.EntityInstance, intervalEndTime, counters),
+		AttributeMask: msgObj.AttributeMask,
+		Result:        me.Success,
+	}
+
+	pkt, err := Serialize(omci.GetCurrentDataResponseType, response, omciMsg.TransactionID)
+	if err != nil {
+		omciLogger.WithFields(log.Fields{
+			"Err": err,
+		}).Error("cannot-Serialize-GetCurrentDataResponse")
+		return nil, err
+	}
+	return pkt, nil
+}
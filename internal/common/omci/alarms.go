@@ -73,6 +73,59 @@ func CreateUniStatusAlarm(raiseAlarm bool, entityId uint16, sequenceNo uint8) ([
 	return pkt, notif.AlarmBitmap
 }
 
+// ValidateMeAlarm checks that the ME class declares an alarm map and that the
+// alarm bit is defined in it
+func ValidateMeAlarm(classID me.ClassID, entityId uint16, alarmBit uint8) error {
+	meDefinition, omciErr := me.LoadManagedEntityDefinition(classID, me.ParamData{EntityID: entityId})
+	if omciErr.StatusCode() != me.Success {
+		return omciErr.GetError()
+	}
+	alarmMap := meDefinition.GetAlarmMap()
+	if alarmMap == nil {
+		return fmt.Errorf("me-class-%v-does-not-declare-an-alarm-map", classID)
+	}
+	if _, ok := alarmMap[alarmBit]; !ok {
+		return fmt.Errorf("me-class-%v-alarm-map-does-not-define-bit-%d", classID, alarmBit)
+	}
+	return nil
+}
+
+// CreateMeAlarm generates an Alarm packet raising or clearing one alarm bit
+// of an ME instance on top of its currently active alarm bitmap
+func CreateMeAlarm(raiseAlarm bool, classID me.ClassID, entityId uint16, alarmBit uint8, sequenceNo uint8, activeBitmap [28]byte) ([]byte, [28]byte) {
+	notif := &omci.AlarmNotificationMsg{
+		MeBasePacket: omci.MeBasePacket{
+			EntityClass:    classID,
+			EntityInstance: entityId,
+		},
+		AlarmSequenceNumber: byte(sequenceNo),
+		AlarmBitmap:         activeBitmap,
+	}
+	if raiseAlarm {
+		if err := notif.ActivateAlarm(alarmBit); err != nil {
+			omciLogger.WithFields(log.Fields{
+				"Err": err,
+			}).Error("Cannot Create AlarmNotificationMsg")
+			return nil, [28]byte{}
+		}
+	} else {
+		if err := notif.ClearAlarm(alarmBit); err != nil {
+			omciLogger.WithFields(log.Fields{
+				"Err": err,
+			}).Error("Cannot Create AlarmNotificationMsg")
+			return nil, [28]byte{}
+		}
+	}
+	pkt, err := Serialize(omci.AlarmNotificationType, notif, 0)
+	if err != nil {
+		omciLogger.WithFields(log.Fields{
+			"Err": err,
+		}).Error("Cannot Serialize AlarmNotificationMsg")
+		return nil, [28]byte{}
+	}
+	return pkt, notif.AlarmBitmap
+}
+
 func CreateGetAllAlarmsResponse(omciMsg *omci.OMCI, onuAlarmDetails map[OnuAlarmInfoMapKey]OnuAlarmInfo) ([]byte, error) {
 	var alarmEntityClass me.ClassID
 	var noOfCommands uint16 = 0
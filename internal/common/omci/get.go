@@ -146,6 +146,84 @@ func CreateGetResponse(omciPkt gopacket.Packet, omciMsg *omci.OMCI, onuSn *openo
 	return pkt, nil
 }
 
+// CreateGetUnsupportedMeResponse returns a GetResponse carrying an
+// UnknownEntity result, used when the ONU is configured to not support the
+// requested managed entity
+func CreateGetUnsupportedMeResponse(omciPkt gopacket.Packet, omciMsg *omci.OMCI) ([]byte, error) {
+	msgObj, err := ParseGetRequest(omciPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &omci.GetResponse{
+		MeBasePacket: omci.MeBasePacket{
+			EntityClass:    msgObj.EntityClass,
+			EntityInstance: msgObj.EntityInstance,
+			Extended:       omciMsg.DeviceIdentifier == omci.ExtendedIdent,
+		},
+		Result: me.UnknownEntity,
+	}
+
+	pkt, err := Serialize(omci.GetResponseType, response, omciMsg.TransactionID)
+	if err != nil {
+		omciLogger.WithFields(log.Fields{
+			"Err": err,
+		}).Error("cannot-Serialize-GetResponse")
+		return nil, err
+	}
+	return pkt, nil
+}
+
+// CreateMcastSubscriberMonitorResponse returns a GetResponse for the
+// MulticastSubscriberMonitor ME carrying the live multicast counters of the
+// ONU. Table attributes of this ME are read through Get size plus GetNext
+// and are masked out here
+func CreateMcastSubscriberMonitorResponse(omciPkt gopacket.Packet, omciMsg *omci.OMCI, joinMessages uint32,
+	bandwidthExceeded uint32, currentBandwidth uint32) ([]byte, error) {
+	msgObj, err := ParseGetRequest(omciPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	managedEntity, meErr := me.NewMulticastSubscriberMonitor(me.ParamData{
+		EntityID: msgObj.EntityInstance,
+		Attributes: me.AttributeValueMap{
+			me.ManagedEntityID: msgObj.EntityInstance,
+			me.MulticastSubscriberMonitor_MeType:                    0,
+			me.MulticastSubscriberMonitor_CurrentMulticastBandwidth: currentBandwidth,
+			me.MulticastSubscriberMonitor_JoinMessagesCounter:       joinMessages,
+			me.MulticastSubscriberMonitor_BandwidthExceededCounter:  bandwidthExceeded,
+		},
+	})
+
+	if meErr.GetError() != nil {
+		omciLogger.Errorf("NewMulticastSubscriberMonitor %v", meErr.Error())
+		return nil, meErr.GetError()
+	}
+
+	response := &omci.GetResponse{
+		MeBasePacket: omci.MeBasePacket{
+			EntityClass:    msgObj.EntityClass,
+			EntityInstance: msgObj.EntityInstance,
+			Extended:       omciMsg.DeviceIdentifier == omci.ExtendedIdent,
+		},
+		Attributes: managedEntity.GetAttributeValueMap(),
+		// only the counter attributes are served here, the table attributes
+		// are paged out via GetNext
+		AttributeMask: msgObj.AttributeMask & 0xf000,
+		Result:        me.Success,
+	}
+
+	pkt, err := Serialize(omci.GetResponseType, response, omciMsg.TransactionID)
+	if err != nil {
+		omciLogger.WithFields(log.Fields{
+			"Err": err,
+		}).Error("cannot-Serialize-GetResponse")
+		return nil, err
+	}
+	return pkt, nil
+}
+
 func createOnu2gResponse(isExtended bool, attributeMask uint16, entityID uint16) *omci.GetResponse {
 
 	managedEntity, meErr := me.NewOnu2G(me.ParamData{
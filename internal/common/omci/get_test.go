@@ -213,3 +213,29 @@ func TestCreateOnugResponse(t *testing.T) {
 	serialNumber := snVendorPart + snNumberPart
 	assert.Equal(t, serialNumber, "BBSM00010101")
 }
+
+func TestCreateMcastSubscriberMonitorResponse(t *testing.T) {
+
+	request := &omci.GetRequest{
+		MeBasePacket: omci.MeBasePacket{
+			EntityClass:    me.MulticastSubscriberMonitorClassID,
+			EntityInstance: 257,
+		},
+		// JoinMessagesCounter and BandwidthExceededCounter
+		AttributeMask: 0x3000,
+	}
+	reqData, err := Serialize(omci.GetRequestType, request, 2)
+	assert.NilError(t, err)
+	omciMsg, omciPkt := omciBytesToMsg(t, reqData)
+
+	data, err := CreateMcastSubscriberMonitorResponse(*omciPkt, omciMsg, 7, 2, 10000000)
+	assert.NilError(t, err)
+
+	respMsg, respPkt := omciBytesToMsg(t, data)
+	assert.Equal(t, respMsg.MessageType, omci.GetResponseType)
+
+	getResponseLayer := omciToGetResponse(t, respPkt)
+	assert.Equal(t, getResponseLayer.Result, me.Success)
+	assert.Equal(t, getResponseLayer.Attributes[me.MulticastSubscriberMonitor_JoinMessagesCounter].(uint32), uint32(7))
+	assert.Equal(t, getResponseLayer.Attributes[me.MulticastSubscriberMonitor_BandwidthExceededCounter].(uint32), uint32(2))
+}
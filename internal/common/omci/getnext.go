@@ -0,0 +1,226 @@
+/*
+ * Copyright 2018-2023 Open Networking Foundation (ONF) and the ONF Contributors
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+ * http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package omci
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/google/gopacket"
+	"github.com/opencord/omci-lib-go/v2"
+	me "github.com/opencord/omci-lib-go/v2/generated"
+	log "github.com/sirupsen/logrus"
+)
+
+// number of rows in the synthetic tables served to the OLT
+const tableAttributeRows = 5
+
+// per-group bandwidth estimate reported in the active group lists, bytes per second
+const mcastGroupBandwidthEstimate = 5000000
+
+// attribute space left in a baseline GetNext response once the
+// result code and attribute mask have been accounted for
+const getNextBaselinePayload = omci.MaxBaselineLength - 11 - 8
+
+func ParseGetNextRequest(omciPkt gopacket.Packet) (*omci.GetNextRequest, error) {
+	msgLayer := omciPkt.Layer(omci.LayerTypeGetNextRequest)
+	if msgLayer == nil {
+		err := "omci Msg layer could not be detected for LayerTypeGetNextRequest"
+		omciLogger.Error(err)
+		return nil, errors.New(err)
+	}
+	msgObj, msgOk := msgLayer.(*omci.GetNextRequest)
+	if !msgOk {
+		err := "omci Msg layer could not be assigned for LayerTypeGetNextRequest"
+		omciLogger.Error(err)
+		return nil, errors.New(err)
+	}
+	return msgObj, nil
+}
+
+// TableAttributeDefinition returns the definition of the table attribute
+// selected by attributeMask, or nil if the mask does not select exactly one
+// table attribute of the managed entity
+func TableAttributeDefinition(classID me.ClassID, entityInstance uint16, attributeMask uint16) *me.AttributeDefinition {
+	meDefinition, omciErr := me.LoadManagedEntityDefinition(classID, me.ParamData{EntityID: entityInstance})
+	if omciErr.StatusCode() != me.Success {
+		return nil
+	}
+	for _, attr := range meDefinition.GetAttributeDefinitions() {
+		if attr.Mask == attributeMask && attr.IsTableAttribute() {
+			attrCopy := attr
+			return &attrCopy
+		}
+	}
+	return nil
+}
+
+// GenerateTableData builds the rows backing a table attribute. The multicast
+// subscriber monitor active group lists get plausible looking rows, any other
+// table attribute is filled with patterned rows of the right size
+func GenerateTableData(classID me.ClassID, entityInstance uint16, attributeMask uint16) []byte {
+	attr := TableAttributeDefinition(classID, entityInstance, attributeMask)
+	if attr == nil {
+		return nil
+	}
+	rowSize := attr.GetSize()
+	if rowSize == 0 {
+		return nil
+	}
+
+	table := make([]byte, 0, tableAttributeRows*rowSize)
+	for i := 0; i < tableAttributeRows; i++ {
+		row := make([]byte, rowSize)
+		if classID == me.MulticastSubscriberMonitorClassID && attr.GetName() == me.MulticastSubscriberMonitor_Ipv4ActiveGroupListTable {
+			// G.988 9.3.30: VLAN, source IP, group IP, bandwidth estimate,
+			// client IP and time since the last join
+			binary.BigEndian.PutUint16(row[0:], 55)
+			copy(row[6:], []byte{224, 0, 0, byte(10 + i)})
+			binary.BigEndian.PutUint32(row[10:], mcastGroupBandwidthEstimate)
+			copy(row[14:], []byte{10, 0, byte(entityInstance >> 8), byte(entityInstance)})
+			binary.BigEndian.PutUint32(row[18:], uint32(60*(i+1)))
+		} else {
+			for j := range row {
+				row[j] = byte(i + 1)
+			}
+			binary.BigEndian.PutUint16(row[0:], uint16(i))
+		}
+		table = append(table, row...)
+	}
+	return table
+}
+
+// BuildIpv4ActiveGroupListTable renders the active multicast groups of an
+// ONU as rows of the multicast subscriber monitor IPv4 active group list
+func BuildIpv4ActiveGroupListTable(groupIds []uint32) []byte {
+	attr := TableAttributeDefinition(me.MulticastSubscriberMonitorClassID, 0, 0x0800)
+	if attr == nil {
+		return nil
+	}
+	rowSize := attr.GetSize()
+
+	table := make([]byte, 0, len(groupIds)*rowSize)
+	for _, groupId := range groupIds {
+		row := make([]byte, rowSize)
+		// the simulator tracks groups by id, map them into the IPv4
+		// multicast range for the destination address
+		copy(row[6:], []byte{224, byte(groupId >> 16), byte(groupId >> 8), byte(groupId)})
+		binary.BigEndian.PutUint32(row[10:], mcastGroupBandwidthEstimate)
+		table = append(table, row...)
+	}
+	return table
+}
+
+// CreateGetTableSizeResponse returns the GetResponse sent when a table
+// attribute is read with a plain Get: the attribute value carries the size of
+// the table in bytes and the OLT is expected to page through the rows with
+// GetNext requests starting from sequence number 0
+func CreateGetTableSizeResponse(omciPkt gopacket.Packet, omciMsg *omci.OMCI, tableLen uint32) ([]byte, error) {
+	msgObj, err := ParseGetRequest(omciPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	attr := TableAttributeDefinition(msgObj.EntityClass, msgObj.EntityInstance, msgObj.AttributeMask)
+	if attr == nil {
+		return nil, fmt.Errorf("attribute-mask-%x-does-not-select-a-table-attribute-of-%v", msgObj.AttributeMask, msgObj.EntityClass)
+	}
+
+	response := &omci.GetResponse{
+		MeBasePacket: omci.MeBasePacket{
+			EntityClass:    msgObj.EntityClass,
+			EntityInstance: msgObj.EntityInstance,
+			Extended:       omciMsg.DeviceIdentifier == omci.ExtendedIdent,
+		},
+		Result:        me.Success,
+		AttributeMask: msgObj.AttributeMask,
+		Attributes: me.AttributeValueMap{
+			attr.GetName(): tableLen,
+		},
+	}
+
+	pkt, err := Serialize(omci.GetResponseType, response, omciMsg.TransactionID)
+	if err != nil {
+		omciLogger.WithFields(log.Fields{
+			"Err": err,
+		}).Error("cannot-Serialize-GetResponse")
+		return nil, err
+	}
+	return pkt, nil
+}
+
+// CreateGetNextResponse serves one fragment of a previously latched table
+// snapshot. The requested sequence number selects the fragment; reading past
+// the end of the snapshot (or reading without a preceding Get) returns a
+// ProcessingError so the OLT knows to restart from a fresh Get
+func CreateGetNextResponse(omciPkt gopacket.Packet, omciMsg *omci.OMCI, snapshot []byte) ([]byte, error) {
+	msgObj, err := ParseGetNextRequest(omciPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	attr := TableAttributeDefinition(msgObj.EntityClass, msgObj.EntityInstance, msgObj.AttributeMask)
+	if attr == nil {
+		return nil, fmt.Errorf("attribute-mask-%x-does-not-select-a-table-attribute-of-%v", msgObj.AttributeMask, msgObj.EntityClass)
+	}
+
+	// send as many whole rows as fit in a baseline message
+	rowSize := attr.GetSize()
+	chunkSize := (getNextBaselinePayload / rowSize) * rowSize
+	if chunkSize == 0 {
+		chunkSize = rowSize
+	}
+
+	response := &omci.GetNextResponse{
+		MeBasePacket: omci.MeBasePacket{
+			EntityClass:    msgObj.EntityClass,
+			EntityInstance: msgObj.EntityInstance,
+			Extended:       omciMsg.DeviceIdentifier == omci.ExtendedIdent,
+		},
+	}
+
+	offset := int(msgObj.SequenceNumber) * chunkSize
+	if offset >= len(snapshot) {
+		omciLogger.WithFields(log.Fields{
+			"EntityClass":    msgObj.EntityClass,
+			"EntityInstance": msgObj.EntityInstance,
+			"SequenceNumber": msgObj.SequenceNumber,
+			"SnapshotLen":    len(snapshot),
+		}).Warn("get-next-sequence-number-out-of-range")
+		response.Result = me.ProcessingError
+	} else {
+		end := offset + chunkSize
+		if end > len(snapshot) {
+			end = len(snapshot)
+		}
+		response.Result = me.Success
+		response.AttributeMask = msgObj.AttributeMask
+		response.Attributes = me.AttributeValueMap{
+			attr.GetName(): snapshot[offset:end],
+		}
+	}
+
+	pkt, err := Serialize(omci.GetNextResponseType, response, omciMsg.TransactionID)
+	if err != nil {
+		omciLogger.WithFields(log.Fields{
+			"Err": err,
+		}).Error("cannot-Serialize-GetNextResponse")
+		return nil, err
+	}
+	return pkt, nil
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright 2018-2023 Open Networking Foundation (ONF) and the ONF Contributors
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+ * http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package omci
+
+import (
+	"testing"
+
+	"github.com/google/gopacket"
+	"github.com/opencord/omci-lib-go/v2"
+	me "github.com/opencord/omci-lib-go/v2/generated"
+	"gotest.tools/assert"
+)
+
+const mcastIpv4TableMask uint16 = 0x0800
+
+func makeGetNextRequest(t *testing.T, entityInstance uint16, sequenceNumber uint16) (gopacket.Packet, *omci.OMCI) {
+	request := &omci.GetNextRequest{
+		MeBasePacket: omci.MeBasePacket{
+			EntityClass:    me.MulticastSubscriberMonitorClassID,
+			EntityInstance: entityInstance,
+		},
+		AttributeMask:  mcastIpv4TableMask,
+		SequenceNumber: sequenceNumber,
+	}
+
+	data, err := Serialize(omci.GetNextRequestType, request, 0x66)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	omciMsg, omciPkt := omciBytesToMsg(t, data)
+	return *omciPkt, omciMsg
+}
+
+func omciToGetNextResponse(t *testing.T, omciPkt *gopacket.Packet) *omci.GetNextResponse {
+	msgLayer := (*omciPkt).Layer(omci.LayerTypeGetNextResponse)
+	if msgLayer == nil {
+		t.Fatal("omci Msg layer could not be detected for GetNextResponse")
+	}
+	msgObj, msgOk := msgLayer.(*omci.GetNextResponse)
+	if !msgOk {
+		t.Fatal("omci Msg layer could not be assigned for GetNextResponse")
+	}
+	return msgObj
+}
+
+func TestCreateGetNextResponse(t *testing.T) {
+
+	table := GenerateTableData(me.MulticastSubscriberMonitorClassID, 1, mcastIpv4TableMask)
+	assert.Assert(t, len(table) > 0)
+
+	attr := TableAttributeDefinition(me.MulticastSubscriberMonitorClassID, 1, mcastIpv4TableMask)
+	assert.Assert(t, attr != nil)
+	rowSize := attr.GetSize()
+	assert.Equal(t, len(table)%rowSize, 0)
+
+	// page through the whole table and make sure the fragments add up to the
+	// latched snapshot
+	read := []byte{}
+	rows := len(table) / rowSize
+	for seq := 0; seq < rows; seq++ {
+		pkt, msg := makeGetNextRequest(t, 1, uint16(seq))
+		data, err := CreateGetNextResponse(pkt, msg, table)
+		assert.NilError(t, err)
+
+		_, respPkt := omciBytesToMsg(t, data)
+		response := omciToGetNextResponse(t, respPkt)
+		assert.Equal(t, response.Result, me.Success)
+
+		fragment, ok := response.Attributes[attr.GetName()].([]byte)
+		assert.Assert(t, ok)
+		// the baseline content area is fixed size so the fragment carries
+		// trailing padding, only the rows are meaningful
+		read = append(read, fragment[:rowSize]...)
+	}
+	assert.DeepEqual(t, read, table)
+
+	// reading past the end of the snapshot must fail so the OLT restarts
+	// from a fresh Get
+	pkt, msg := makeGetNextRequest(t, 1, uint16(rows))
+	data, err := CreateGetNextResponse(pkt, msg, table)
+	assert.NilError(t, err)
+	_, respPkt := omciBytesToMsg(t, data)
+	response := omciToGetNextResponse(t, respPkt)
+	assert.Equal(t, response.Result, me.ProcessingError)
+}
@@ -187,7 +187,7 @@ func TestCreateMibUploadNextResponse(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 
 			// create the packet starting from the mibUploadNextRequest
-			data, err := CreateMibUploadNextResponse(tt.args.omciPkt, tt.args.omciMsg, &mibDb)
+			data, err := CreateMibUploadNextResponse(tt.args.omciPkt, tt.args.omciMsg, &mibDb, 0)
 			assert.NilError(t, err)
 			omciMsg, omciPkt := omciBytesToMsg(t, data)
 
@@ -219,9 +219,24 @@ func TestCreateMibUploadNextResponse(t *testing.T) {
 		})
 	}
 
+	// the ONU-Data entry reports the live MIB-data-sync counter, not the
+	// value latched when the MIB database was generated
+	mdsArgs := createTestMibUploadNextArgs(t, 6, 0)
+	data, err := CreateMibUploadNextResponse(mdsArgs.omciPkt, mdsArgs.omciMsg, &mibDb, 42)
+	assert.NilError(t, err)
+	_, omciPkt := omciBytesToMsg(t, data)
+	msgLayer := (*omciPkt).Layer(omci.LayerTypeMibUploadNextResponse)
+	msgObj, msgOk := msgLayer.(*omci.MibUploadNextResponse)
+	if !msgOk {
+		t.Fail()
+	}
+	mds, err := msgObj.ReportedME.GetAttribute(me.OnuData_MibDataSync)
+	assert.NilError(t, err)
+	assert.Equal(t, mds, uint8(42))
+
 	// now try to get a non existing command from the DB anche expect an error
 	args := createTestMibUploadNextArgs(t, 1, 20)
-	_, err := CreateMibUploadNextResponse(args.omciPkt, args.omciMsg, &mibDb)
+	_, err = CreateMibUploadNextResponse(args.omciPkt, args.omciMsg, &mibDb, 0)
 	assert.Error(t, err, "mibdb-does-not-contain-item")
 }
 
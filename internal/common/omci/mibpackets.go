@@ -193,7 +193,7 @@ func ParseMibUploadNextRequest(omciPkt gopacket.Packet) (*omci.MibUploadNextRequ
 	return msgObj, nil
 }
 
-func CreateMibUploadNextResponse(omciPkt gopacket.Packet, omciMsg *omci.OMCI, mibDb *MibDb) ([]byte, error) {
+func CreateMibUploadNextResponse(omciPkt gopacket.Packet, omciMsg *omci.OMCI, mibDb *MibDb, mds uint8) ([]byte, error) {
 
 	msgObj, err := ParseMibUploadNextRequest(omciPkt)
 	if err != nil {
@@ -227,6 +227,13 @@ func CreateMibUploadNextResponse(omciPkt gopacket.Packet, omciMsg *omci.OMCI, mi
 	} else {
 		currentEntry := mibDb.baselineItems[int(msgObj.CommandSequenceNumber)]
 
+		// the MIB database is generated once per ONU: report the live
+		// MIB-data-sync counter in the ONU-Data ME instead of the value
+		// latched at creation
+		if currentEntry.classId == me.OnuDataClassID {
+			currentEntry.params = me.AttributeValueMap{me.OnuData_MibDataSync: mds}
+		}
+
 		// if packet is set then we don't need to serialize the packet, it's already done
 		if currentEntry.packet != nil {
 			omciLogger.WithFields(log.Fields{
@@ -0,0 +1,114 @@
+/*
+ * Copyright 2018-2023 Open Networking Foundation (ONF) and the ONF Contributors
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+ * http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package omci
+
+import (
+	"github.com/google/gopacket"
+	"github.com/opencord/omci-lib-go/v2"
+	me "github.com/opencord/omci-lib-go/v2/generated"
+	log "github.com/sirupsen/logrus"
+)
+
+// layout of the OctetString ME payload: up to 375 bytes split across
+// 15 parts of 25 bytes each
+const (
+	OctetStringPartSize  = 25
+	OctetStringMaxParts  = 15
+	OctetStringMaxLength = OctetStringPartSize * OctetStringMaxParts
+)
+
+var octetStringPartNames = []string{
+	me.OctetString_Part1, me.OctetString_Part2, me.OctetString_Part3,
+	me.OctetString_Part4, me.OctetString_Part5, me.OctetString_Part6,
+	me.OctetString_Part7, me.OctetString_Part8, me.OctetString_Part9,
+	me.OctetString_Part10, me.OctetString_Part11, me.OctetString_Part12,
+	me.OctetString_Part13, me.OctetString_Part14, me.OctetString_Part15,
+}
+
+// ApplyOctetStringAttributes copies the Length and Part attributes of a Set
+// request into the stored payload, returning false when a value does not
+// have the expected type
+func ApplyOctetStringAttributes(attrs me.AttributeValueMap, length *uint16, data []byte) bool {
+	if v, ok := attrs[me.OctetString_Length]; ok {
+		l, lOk := v.(uint16)
+		if !lOk || l > OctetStringMaxLength {
+			return false
+		}
+		*length = l
+	}
+	for i, name := range octetStringPartNames {
+		if v, ok := attrs[name]; ok {
+			part, pOk := v.([]byte)
+			if !pOk {
+				return false
+			}
+			copy(data[i*OctetStringPartSize:(i+1)*OctetStringPartSize], part)
+		}
+	}
+	return true
+}
+
+// CreateOctetStringResponse builds the GetResponse for an OctetString ME
+// instance. The parts carry the stored payload up to the Length attribute,
+// a Get on a missing instance returns UnknownInstance
+func CreateOctetStringResponse(omciPkt gopacket.Packet, omciMsg *omci.OMCI, exists bool, length uint16, data []byte) ([]byte, error) {
+	msgObj, err := ParseGetRequest(omciPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &omci.GetResponse{
+		MeBasePacket: omci.MeBasePacket{
+			EntityClass:    msgObj.EntityClass,
+			EntityInstance: msgObj.EntityInstance,
+			Extended:       omciMsg.DeviceIdentifier == omci.ExtendedIdent,
+		},
+	}
+
+	if !exists {
+		response.Result = me.UnknownInstance
+	} else {
+		attrs := me.AttributeValueMap{
+			me.ManagedEntityID:    msgObj.EntityInstance,
+			me.OctetString_Length: length,
+		}
+		for i, name := range octetStringPartNames {
+			part := make([]byte, OctetStringPartSize)
+			offset := i * OctetStringPartSize
+			if offset < int(length) {
+				end := offset + OctetStringPartSize
+				if end > int(length) {
+					end = int(length)
+				}
+				copy(part, data[offset:end])
+			}
+			attrs[name] = part
+		}
+		response.Result = me.Success
+		response.AttributeMask = msgObj.AttributeMask
+		response.Attributes = attrs
+	}
+
+	pkt, err := Serialize(omci.GetResponseType, response, omciMsg.TransactionID)
+	if err != nil {
+		omciLogger.WithFields(log.Fields{
+			"Err": err,
+		}).Error("cannot-Serialize-GetResponse")
+		return nil, err
+	}
+	return pkt, nil
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2018-2023 Open Networking Foundation (ONF) and the ONF Contributors
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+ * http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package omci
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/opencord/omci-lib-go/v2"
+	me "github.com/opencord/omci-lib-go/v2/generated"
+	"gotest.tools/assert"
+)
+
+func TestApplyOctetStringAttributes(t *testing.T) {
+
+	var length uint16
+	data := make([]byte, OctetStringMaxLength)
+
+	part := bytes.Repeat([]byte{0x42}, OctetStringPartSize)
+	ok := ApplyOctetStringAttributes(me.AttributeValueMap{
+		me.OctetString_Length: uint16(30),
+		me.OctetString_Part1:  part,
+		me.OctetString_Part2:  part,
+	}, &length, data)
+	assert.Assert(t, ok)
+	assert.Equal(t, length, uint16(30))
+	assert.DeepEqual(t, data[:2*OctetStringPartSize], bytes.Repeat([]byte{0x42}, 2*OctetStringPartSize))
+
+	// a length beyond the 375 byte payload is rejected
+	ok = ApplyOctetStringAttributes(me.AttributeValueMap{
+		me.OctetString_Length: uint16(OctetStringMaxLength + 1),
+	}, &length, data)
+	assert.Assert(t, !ok)
+}
+
+func TestCreateOctetStringResponse(t *testing.T) {
+
+	request := &omci.GetRequest{
+		MeBasePacket: omci.MeBasePacket{
+			EntityClass:    me.OctetStringClassID,
+			EntityInstance: 1,
+		},
+		// Part1 only
+		AttributeMask: 0x4000,
+	}
+	reqData, err := Serialize(omci.GetRequestType, request, 3)
+	assert.NilError(t, err)
+	omciMsg, omciPkt := omciBytesToMsg(t, reqData)
+
+	data := make([]byte, OctetStringMaxLength)
+	copy(data, []byte("hello-octet-string"))
+
+	pkt, err := CreateOctetStringResponse(*omciPkt, omciMsg, true, 18, data)
+	assert.NilError(t, err)
+
+	respMsg, respPkt := omciBytesToMsg(t, pkt)
+	assert.Equal(t, respMsg.MessageType, omci.GetResponseType)
+	getResponseLayer := omciToGetResponse(t, respPkt)
+	assert.Equal(t, getResponseLayer.Result, me.Success)
+	part := getResponseLayer.Attributes[me.OctetString_Part1].([]byte)
+	assert.DeepEqual(t, part[:18], []byte("hello-octet-string"))
+
+	// a Get on a missing instance returns UnknownInstance
+	pkt, err = CreateOctetStringResponse(*omciPkt, omciMsg, false, 0, nil)
+	assert.NilError(t, err)
+	_, respPkt = omciBytesToMsg(t, pkt)
+	getResponseLayer = omciToGetResponse(t, respPkt)
+	assert.Equal(t, getResponseLayer.Result, me.UnknownInstance)
+}
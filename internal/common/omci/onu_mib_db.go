@@ -123,7 +123,7 @@ func GenerateMibDatabase(ethUniPortCount int, potsUniPortCount int, technology c
 	mibDb.baselineItems = append(mibDb.baselineItems, MibDbEntry{
 		me.OnuDataClassID,
 		EntityID{0x00, 0x00},
-		me.AttributeValueMap{me.OnuData_MibDataSync: 0}, // FIXME this needs to be parametrized before sending the response
+		me.AttributeValueMap{me.OnuData_MibDataSync: 0}, // overridden with the ONU's live counter when the response is sent
 		nil,
 	})
 
@@ -142,7 +142,8 @@ func GenerateMibDatabase(ethUniPortCount int, potsUniPortCount int, technology c
 	var aniCPType byte
 
 	switch technology {
-	case common.XGSPON:
+	case common.XGSPON, common.TWENTYFIVEGPON:
+		// 25G-PON ONUs report the same circuit pack type as XGS-PON
 		aniCPType = xgsPonUnitType
 	case common.GPON:
 		aniCPType = gPonUnitType
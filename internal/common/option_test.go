@@ -222,3 +222,32 @@ func TestGetPonConfigById(t *testing.T) {
 
 	assert.Assert(t, err != nil, "Invalid pon id returned configuration")
 }
+
+func TestSetPonTechnology(t *testing.T) {
+	PonsConfig = getTestPonsConfiguration()
+
+	// changing a port in the middle of a range splits it in three
+	assert.NilError(t, SetPonTechnology(4, TWENTYFIVEGPON.String()))
+	assert.Equal(t, len(PonsConfig.Ranges), 4)
+	assert.Equal(t, PonsConfig.Ranges[0].PonRange, IdRange{0, 3})
+	assert.Equal(t, PonsConfig.Ranges[0].Technology, GPON.String())
+	assert.Equal(t, PonsConfig.Ranges[1].PonRange, IdRange{4, 4})
+	assert.Equal(t, PonsConfig.Ranges[1].Technology, TWENTYFIVEGPON.String())
+	assert.Equal(t, PonsConfig.Ranges[2].PonRange, IdRange{5, 7})
+	assert.Equal(t, PonsConfig.Ranges[2].Technology, GPON.String())
+
+	// the resource pools are preserved across the split
+	assert.Equal(t, PonsConfig.Ranges[1].AllocIdRange, PonsConfig.Ranges[0].AllocIdRange)
+	assert.Equal(t, PonsConfig.Ranges[1].GemportRange, PonsConfig.Ranges[0].GemportRange)
+
+	// the updated configuration is still valid
+	assert.NilError(t, validatePonsConfig(PonsConfig))
+
+	// setting the technology a port already has is a no-op
+	assert.NilError(t, SetPonTechnology(4, TWENTYFIVEGPON.String()))
+	assert.Equal(t, len(PonsConfig.Ranges), 4)
+
+	// unknown technologies and ports are rejected
+	assert.ErrorContains(t, SetPonTechnology(0, "BPON"), "technology")
+	assert.ErrorContains(t, SetPonTechnology(42, TWENTYFIVEGPON.String()), "not-found")
+}
@@ -39,6 +39,12 @@ const (
 	BP_FORMAT_IETF = "ietf"
 )
 
+// Valid values for OltConfig.PortStatsSource
+const (
+	PortStatsSourceFile      = "file"
+	PortStatsSourceGenerated = "generated"
+)
+
 type TagAllocation int
 
 func (t TagAllocation) String() string {
@@ -91,10 +97,44 @@ type OltConfig struct {
 	ID                 int    `yaml:"id"`
 	OltRebootDelay     int    `yaml:"reboot_delay"`
 	PortStatsInterval  int    `yaml:"port_stats_interval"`
+	PortStatsSource    string `yaml:"port_stats_source"`
+	PonSpeed           uint32 `yaml:"pon_speed"`
 	OmciResponseRate   uint8  `yaml:"omci_response_rate"`
 	UniPorts           uint32 `yaml:"uni_ports"`
 	PotsPorts          uint32 `yaml:"pots_ports"`
 	NniDhcpTrapVid     uint32 `yaml:"nni_dhcp_trap_vid"`
+	Dhcpv6Enabled      bool   `yaml:"dhcpv6_enabled"`
+	// maximum total number of ONUs across all PON ports, 0 disables the guard
+	MaxOnus uint32 `yaml:"max_onus"`
+	// depth of the per-ONU message channel; sends to a full channel are
+	// dropped instead of blocking the gRPC handlers
+	OmciChannelDepth uint32 `yaml:"omci_channel_depth"`
+	// OMCI managed entity class IDs every ONU reports as unsupported,
+	// answering Get/Set with an UnknownEntity result
+	UnsupportedOmciMes []uint32 `yaml:"unsupported_omci_mes"`
+	// identity reported by the BOSS GetOltConnect and GetOltDeviceInfo endpoints
+	ManagementIp  string `yaml:"management_ip"`
+	ManagementMac string `yaml:"management_mac"`
+	FpgaType      string `yaml:"fpga_type"`
+	FpgaVersion   string `yaml:"fpga_version"`
+	FpgaDate      string `yaml:"fpga_date"`
+	SwVersion     string `yaml:"sw_version"`
+	SwDate        string `yaml:"sw_date"`
+	// how the heartbeat signature is generated: "unix-seconds" (default),
+	// "random", "counter" or "fixed". With "fixed" the signature is always
+	// signature_value, which golden-file tests rely on
+	SignatureMode  string `yaml:"signature_mode"`
+	SignatureValue uint32 `yaml:"signature_value"`
+	// interval after which the OLT indication loop is reported as stuck if
+	// messages are pending but none was processed, 0 disables the watchdog
+	MsgWatchdogIntervalMs uint32 `yaml:"msg_watchdog_interval_ms"`
+	// pins deterministic serial numbers to specific ONU slots, keyed by
+	// "<pon id>/<onu id>" (e.g. "0/1"); slots missing from the map get a
+	// generated serial
+	OnuSerials map[string]string `yaml:"onu_serials"`
+	// pins vendor ids to specific ONU slots, keyed like onu_serials; slots
+	// missing from the map report the default "ETRI"
+	OnuVendors map[string]string `yaml:"onu_vendors"`
 }
 
 type PonPortsConfig struct {
@@ -110,7 +150,7 @@ type IdRange struct {
 type PonTechnology int
 
 var ponTechnologyValues = []string{
-	"GPON", "XGS-PON",
+	"GPON", "XGS-PON", "25G-PON",
 }
 
 func (t PonTechnology) String() string {
@@ -120,8 +160,27 @@ func (t PonTechnology) String() string {
 const (
 	GPON PonTechnology = iota
 	XGSPON
+	TWENTYFIVEGPON
 )
 
+// Valid values for PonRangeConfig.PoolSharing, mirroring the pool sharing
+// types defined by the openolt protos
+var poolSharingValues = []string{
+	"DEDICATED_PER_INTF", "SHARED_BY_ALL_INTF_ALL_TECH", "SHARED_BY_ALL_INTF_SAME_TECH",
+}
+
+func validatePoolSharing(s string) error {
+	for _, val := range poolSharingValues {
+		if val == s {
+			return nil
+		}
+	}
+	log.WithFields(log.Fields{
+		"ValidValues": strings.Join(poolSharingValues[:], ", "),
+	}).Errorf("%s-is-not-a-valid-pool-sharing-type", s)
+	return fmt.Errorf("%s-is-not-a-valid-pool-sharing-type", s)
+}
+
 func PonTechnologyFromString(s string) (PonTechnology, error) {
 	for i, val := range ponTechnologyValues {
 		if val == s {
@@ -134,7 +193,7 @@ func PonTechnologyFromString(s string) (PonTechnology, error) {
 	return -1, fmt.Errorf("%s-is-not-a-valid-pon-technology", s)
 }
 
-//Constants for default allocation ranges
+// Constants for default allocation ranges
 const (
 	defaultOnuIdStart          = 1
 	defaultAllocIdStart        = 1024
@@ -143,11 +202,35 @@ const (
 )
 
 type PonRangeConfig struct {
-	PonRange     IdRange `yaml:"pon_id_range"`
-	Technology   string  `yaml:"tech"`
+	PonRange   IdRange `yaml:"pon_id_range"`
+	Technology string  `yaml:"tech"`
+	// sharing mode reported for the resource pools of this range
+	// (one of poolSharingValues, empty selects DEDICATED_PER_INTF)
+	PoolSharing  string  `yaml:"pool_sharing"`
 	OnuRange     IdRange `yaml:"onu_id_range"`
 	AllocIdRange IdRange `yaml:"alloc_id_range"`
 	GemportRange IdRange `yaml:"gemport_id_range"`
+	// artificial propagation delay applied to data-plane packets
+	// traversing the PON ports in this range (0 disables the injection)
+	LatencyMs uint32 `yaml:"latency_ms"`
+	JitterMs  uint32 `yaml:"jitter_ms"`
+	// probability in [0,1] that a data-plane packet traversing the PON
+	// ports in this range is silently dropped (0 disables the injection)
+	PacketLossRate float64 `yaml:"packet_loss_rate"`
+	// probability in [0,1] that a single ONU discovery attempt on the PON
+	// ports in this range fails, the ONU retries after its
+	// DiscoveryRetryDelay (0 disables the injection)
+	DiscoveryFailureRate float64 `yaml:"discovery_failure_rate"`
+	// guaranteed bandwidth the DBA can commit on each PON port in this
+	// range, in Mbps; SLAs whose fixed/assured bandwidth would exceed it
+	// are rejected (0 disables admission control)
+	DbaCapacityMbps uint32 `yaml:"dba_capacity_mbps"`
+	// simulated optical budget of the PON ports in this range: an ONU
+	// whose fiber distance exceeds max_distance_km or whose rx-power is
+	// below rx_sensitivity_dbm fails activation with a LOS alarm (0
+	// disables the respective check)
+	MaxDistanceKm    uint32  `yaml:"max_distance_km"`
+	RxSensitivityDbm float64 `yaml:"rx_sensitivity_dbm"`
 }
 
 func GetPonConfigById(id uint32) (*PonRangeConfig, error) {
@@ -164,6 +247,66 @@ func GetPonConfigById(id uint32) (*PonRangeConfig, error) {
 	return nil, fmt.Errorf("pon-config-for-id-%d-not-found", id)
 }
 
+// SetPonTechnology changes the technology of a single PON port in the loaded
+// configuration, splitting the range that contains it when the range spans
+// more ports so the others keep their technology. The resource pools of the
+// original range are preserved. The updated ranges are revalidated before
+// being installed, so a bad request leaves the configuration untouched.
+func SetPonTechnology(id uint32, technology string) error {
+	if PonsConfig == nil {
+		return fmt.Errorf("pons-config-nil")
+	}
+
+	if _, err := PonTechnologyFromString(technology); err != nil {
+		return err
+	}
+
+	for rIndex, r := range PonsConfig.Ranges {
+		if id < r.PonRange.StartId || id > r.PonRange.EndId {
+			continue
+		}
+
+		if r.Technology == technology {
+			return nil
+		}
+
+		newRanges := []PonRangeConfig{}
+		newRanges = append(newRanges, PonsConfig.Ranges[:rIndex]...)
+
+		if id > r.PonRange.StartId {
+			before := r
+			before.PonRange = IdRange{StartId: r.PonRange.StartId, EndId: id - 1}
+			newRanges = append(newRanges, before)
+		}
+
+		changed := r
+		changed.PonRange = IdRange{StartId: id, EndId: id}
+		changed.Technology = technology
+		newRanges = append(newRanges, changed)
+
+		if id < r.PonRange.EndId {
+			after := r
+			after.PonRange = IdRange{StartId: id + 1, EndId: r.PonRange.EndId}
+			newRanges = append(newRanges, after)
+		}
+
+		newRanges = append(newRanges, PonsConfig.Ranges[rIndex+1:]...)
+
+		updated := &PonPortsConfig{
+			Number: PonsConfig.Number,
+			Ranges: newRanges,
+		}
+		if err := validatePonsConfig(updated); err != nil {
+			return err
+		}
+
+		PonsConfig = updated
+		return nil
+	}
+
+	return fmt.Errorf("pon-config-for-id-%d-not-found", id)
+}
+
 type BBSimConfig struct {
 	ConfigFile                  string
 	ServiceConfigFile           string
@@ -172,10 +315,16 @@ type BBSimConfig struct {
 	AuthRetry                   bool    `yaml:"auth_retry"`
 	LogLevel                    string  `yaml:"log_level"`
 	LogCaller                   bool    `yaml:"log_caller"`
+	LogDeviceJson               bool    `yaml:"log_device_json"`
 	Delay                       int     `yaml:"delay"`
+	// delay between ONU re-discoveries in milliseconds, applied in the
+	// reboot and delete-rediscover paths. Distinct from Delay, which only
+	// paces the initial discovery batches
+	RediscoveryDelay int `yaml:"rediscovery_delay"`
 	CpuProfile                  *string `yaml:"cpu_profile"`
 	OpenOltAddress              string  `yaml:"openolt_address"`
 	ApiAddress                  string  `yaml:"api_address"`
+	ControlApiAddress           string  `yaml:"control_api_address"`
 	RestApiAddress              string  `yaml:"rest_api_address"`
 	LegacyApiAddress            string  `yaml:"legacy_api_address"`
 	LegacyRestApiAddress        string  `yaml:"legacy_rest_api_address"`
@@ -184,7 +333,10 @@ type BBSimConfig struct {
 	KafkaAddress                string  `yaml:"kafka_address"`
 	Events                      bool    `yaml:"enable_events"`
 	ControlledActivation        string  `yaml:"controlled_activation"`
+	StartupIndicationOrder      string  `yaml:"startup_indication_order"`
+	StartupIndicationDelayMs    uint32  `yaml:"startup_indication_delay_ms"`
 	EnablePerf                  bool    `yaml:"enable_perf"`
+	EnablePortStats             bool    `yaml:"enable_port_stats"`
 	KafkaEventTopic             string  `yaml:"kafka_event_topic"`
 	DmiServerAddress            string  `yaml:"dmi_server_address"`
 	BandwidthProfileFormat      string  `yaml:"bp_format"`
@@ -217,6 +369,8 @@ type ServiceYaml struct {
 	UsPonSTagPriority   uint8  `yaml:"us_pon_s_tag_priority"`
 	DsPonCTagPriority   uint8  `yaml:"ds_pon_c_tag_priority"`
 	DsPonSTagPriority   uint8  `yaml:"ds_pon_s_tag_priority"`
+	EapolResult         string `yaml:"eapol_result"`
+	StaticIp            string `yaml:"static_ip"`
 }
 type YamlServiceConfig struct {
 	Workflow string
@@ -337,12 +491,19 @@ func readCliParams() *GlobalConfig {
 	uni := flag.Int("uni", int(conf.Olt.UniPorts), "Number of Ethernet UNI Ports per ONU device to be emulated")
 	pots := flag.Int("pots", int(conf.Olt.PotsPorts), "Number of POTS UNI Ports per ONU device to be emulated")
 	NniDchpTrapVid := flag.Int("nni_dhcp_trap_vid", int(conf.Olt.NniDhcpTrapVid), "Vlan to trap the DHCP packets on")
+	dhcpv6Enabled := flag.Bool("dhcpv6_enabled", conf.Olt.Dhcpv6Enabled, "Whether DHCPv6 packets trapped at the NNI are answered by the DHCPv6 server")
+	maxOnus := flag.Int("max_onus", int(conf.Olt.MaxOnus), "Maximum total number of ONUs across all PON ports, 0 disables the guard")
+	omciChannelDepth := flag.Int("omci_channel_depth", int(conf.Olt.OmciChannelDepth), "Depth of the per-ONU message channel, messages are dropped when it is full")
+	signatureMode := flag.String("signature_mode", conf.Olt.SignatureMode, "How the heartbeat signature is generated: unix-seconds, random, counter or fixed")
+	signatureValue := flag.Uint("signature_value", uint(conf.Olt.SignatureValue), "Signature reported when signature_mode is fixed")
+	msgWatchdogIntervalMs := flag.Int("msg_watchdog_interval_ms", int(conf.Olt.MsgWatchdogIntervalMs), "Interval in ms after which the OLT indication loop is reported as stuck, 0 disables the watchdog")
 
 	oltRebootDelay := flag.Int("oltRebootDelay", conf.Olt.OltRebootDelay, "Time that BBSim should before restarting after a reboot")
 	omci_response_rate := flag.Int("omci_response_rate", int(conf.Olt.OmciResponseRate), "Amount of OMCI messages to respond to")
 
 	openolt_address := flag.String("openolt_address", conf.BBSim.OpenOltAddress, "IP address:port")
 	api_address := flag.String("api_address", conf.BBSim.ApiAddress, "IP address:port")
+	control_api_address := flag.String("control_api_address", conf.BBSim.ControlApiAddress, "IP address:port of the optional out-of-band control API listener (empty disables it)")
 	rest_api_address := flag.String("rest_api_address", conf.BBSim.RestApiAddress, "IP address:port")
 	dmi_server_address := flag.String("dmi_server_address", conf.BBSim.DmiServerAddress, "IP address:port")
 
@@ -350,11 +511,16 @@ func readCliParams() *GlobalConfig {
 
 	logLevel := flag.String("logLevel", conf.BBSim.LogLevel, "Set the log level (trace, debug, info, warn, error)")
 	logCaller := flag.Bool("logCaller", conf.BBSim.LogCaller, "Whether to print the caller filename or not")
+	logDeviceJson := flag.Bool("logDeviceJson", conf.BBSim.LogDeviceJson, "Emit OLT/ONU device logs in JSON format for structured ingestion")
 
 	delay := flag.Int("delay", conf.BBSim.Delay, "The delay between ONU DISCOVERY batches in milliseconds (1 ONU per each PON PORT at a time")
+	rediscoveryDelay := flag.Int("rediscovery_delay", conf.BBSim.RediscoveryDelay, "The delay between ONU re-discoveries in milliseconds, applied on reboot and delete-rediscover")
 
 	controlledActivation := flag.String("ca", conf.BBSim.ControlledActivation, "Set the mode for controlled activation of PON ports and ONUs")
+	startupIndicationOrder := flag.String("startup_indication_order", conf.BBSim.StartupIndicationOrder, "Order in which the olt, nni and pon indications are sent at startup (comma separated)")
+	startupIndicationDelayMs := flag.Uint("startup_indication_delay_ms", uint(conf.BBSim.StartupIndicationDelayMs), "Delay between the startup indication steps in milliseconds")
 	enablePerf := flag.Bool("enableperf", conf.BBSim.EnablePerf, "Setting this flag will cause BBSim to not store data like traffic schedulers, flows of ONUs etc..")
+	enablePortStats := flag.Bool("enableportstats", conf.BBSim.EnablePortStats, "Enable the periodic port stats reporting, independently of enableperf")
 	enableEvents := flag.Bool("enableEvents", conf.BBSim.Events, "Enable sending BBSim events on configured kafka server")
 	kafkaAddress := flag.String("kafkaAddress", conf.BBSim.KafkaAddress, "IP:Port for kafka")
 	kafkaEventTopic := flag.String("kafkaEventTopic", conf.BBSim.KafkaEventTopic, "Ability to configure the topic on which BBSim publishes events on Kafka")
@@ -374,6 +540,12 @@ func readCliParams() *GlobalConfig {
 	conf.Olt.PotsPorts = uint32(*pots)
 	conf.Olt.OnusPonPort = uint32(*onu)
 	conf.Olt.NniDhcpTrapVid = uint32(*NniDchpTrapVid)
+	conf.Olt.Dhcpv6Enabled = *dhcpv6Enabled
+	conf.Olt.MaxOnus = uint32(*maxOnus)
+	conf.Olt.OmciChannelDepth = uint32(*omciChannelDepth)
+	conf.Olt.SignatureMode = *signatureMode
+	conf.Olt.SignatureValue = uint32(*signatureValue)
+	conf.Olt.MsgWatchdogIntervalMs = uint32(*msgWatchdogIntervalMs)
 	conf.Olt.OltRebootDelay = *oltRebootDelay
 	conf.Olt.OmciResponseRate = uint8(*omci_response_rate)
 	conf.BBSim.ConfigFile = *configFile
@@ -382,13 +554,19 @@ func readCliParams() *GlobalConfig {
 	conf.BBSim.CpuProfile = profileCpu
 	conf.BBSim.LogLevel = *logLevel
 	conf.BBSim.LogCaller = *logCaller
+	conf.BBSim.LogDeviceJson = *logDeviceJson
 	conf.BBSim.Delay = *delay
+	conf.BBSim.RediscoveryDelay = *rediscoveryDelay
 	conf.BBSim.ControlledActivation = *controlledActivation
+	conf.BBSim.StartupIndicationOrder = *startupIndicationOrder
+	conf.BBSim.StartupIndicationDelayMs = uint32(*startupIndicationDelayMs)
 	conf.BBSim.EnablePerf = *enablePerf
+	conf.BBSim.EnablePortStats = *enablePortStats
 	conf.BBSim.Events = *enableEvents
 	conf.BBSim.KafkaAddress = *kafkaAddress
 	conf.BBSim.OpenOltAddress = *openolt_address
 	conf.BBSim.ApiAddress = *api_address
+	conf.BBSim.ControlApiAddress = *control_api_address
 	conf.BBSim.RestApiAddress = *rest_api_address
 	conf.BBSim.KafkaEventTopic = *kafkaEventTopic
 	conf.BBSim.AuthRetry = *authRetry
@@ -424,7 +602,9 @@ func GetDefaultOps() *GlobalConfig {
 			PonsConfigFile:              "",
 			LogLevel:                    "debug",
 			LogCaller:                   false,
+			LogDeviceJson:               false,
 			Delay:                       200,
+			RediscoveryDelay:            0,
 			OpenOltAddress:              ":50060",
 			ApiAddress:                  ":50070",
 			RestApiAddress:              ":50071",
@@ -435,7 +615,10 @@ func GetDefaultOps() *GlobalConfig {
 			KafkaAddress:                ":9092",
 			Events:                      false,
 			ControlledActivation:        "default",
+			StartupIndicationOrder:      "olt,nni,pon",
+			StartupIndicationDelayMs:    0,
 			EnablePerf:                  false,
+			EnablePortStats:             true,
 			KafkaEventTopic:             "",
 			DhcpRetry:                   false,
 			AuthRetry:                   false,
@@ -458,10 +641,23 @@ func GetDefaultOps() *GlobalConfig {
 			ID:                 0,
 			OltRebootDelay:     60,
 			PortStatsInterval:  20,
+			PortStatsSource:    PortStatsSourceFile,
+			PonSpeed:           25000, //Mbps
 			OmciResponseRate:   10,
 			UniPorts:           4,
 			PotsPorts:          0,
 			NniDhcpTrapVid:     0,
+			Dhcpv6Enabled:      false,
+			MaxOnus:            4096,
+			OmciChannelDepth:   2048,
+			SignatureMode:      "unix-seconds",
+			ManagementIp:       "192.168.0.1",
+			ManagementMac:      "00:AA:10:11:13:03",
+			FpgaType:           "25G OLT",
+			FpgaVersion:        "1.0",
+			FpgaDate:           "2020.09.02",
+			SwVersion:          "1.0",
+			SwDate:             "2020.06.30",
 		},
 		BBRConfig{
 			LogLevel:  "debug",
@@ -562,6 +758,12 @@ func validatePonsConfig(pons *PonPortsConfig) error {
 			return err
 		}
 
+		if resRange.PoolSharing != "" {
+			if err := validatePoolSharing(resRange.PoolSharing); err != nil {
+				return err
+			}
+		}
+
 		if resRange.PonRange.EndId < resRange.PonRange.StartId {
 			return fmt.Errorf("invalid-pon-ports-limits-in-range-%d", rIndex)
 		}
@@ -625,6 +827,16 @@ func loadBBSimServices(filename string) ([]ServiceYaml, error) {
 				"err": err,
 			}).Fatal("c_tag_allocation is not valid")
 		}
+
+		if service.EapolResult != "" && service.EapolResult != "success" && service.EapolResult != "failure" {
+			log.Fatal("eapol_result must be either success or failure when set")
+		}
+
+		if service.StaticIp != "" && net.ParseIP(service.StaticIp) == nil {
+			log.WithFields(log.Fields{
+				"static_ip": service.StaticIp,
+			}).Fatal("static_ip is not a valid IP address")
+		}
 	}
 
 	log.WithFields(log.Fields{
@@ -0,0 +1,40 @@
+/*
+ * Copyright 2018-2023 Open Networking Foundation (ONF) and the ONF Contributors
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+ * http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import "sync/atomic"
+
+// number of packet indications forwarded to the controller, incremented
+// wherever a PktInd is sent up the Openolt stream
+var pktIndCount uint64
+
+// IncrementPktIndCount records a packet indication sent to the controller
+func IncrementPktIndCount() {
+	atomic.AddUint64(&pktIndCount, 1)
+}
+
+// GetPktIndCount returns the number of packet indications sent since the
+// last reset, without resetting the counter
+func GetPktIndCount() uint64 {
+	return atomic.LoadUint64(&pktIndCount)
+}
+
+// GetAndResetPktIndCount returns the number of packet indications sent
+// since the last call and resets the counter
+func GetAndResetPktIndCount() uint64 {
+	return atomic.SwapUint64(&pktIndCount, 0)
+}